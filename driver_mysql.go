@@ -0,0 +1,7 @@
+//go:build !nomysql
+// +build !nomysql
+
+package main
+
+// the MySQL driver, leave it out with -tags nomysql
+import _ "github.com/go-sql-driver/mysql"
@@ -0,0 +1,7 @@
+//go:build !nopostgres
+// +build !nopostgres
+
+package main
+
+// the Postgres driver, leave it out with -tags nopostgres
+import _ "github.com/lib/pq"
@@ -0,0 +1,7 @@
+//go:build !nosqlite
+// +build !nosqlite
+
+package main
+
+// the SQLite driver, it needs cgo, leave it out with -tags nosqlite
+import _ "github.com/mattn/go-sqlite3"
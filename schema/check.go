@@ -0,0 +1,260 @@
+package schema
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// Check verifies that the schema in filename is the same as the generated schema without writing anything to disk.
+// Both schemas are formatted with prettier first so formatting-only differences are ignored. With MergeSchema only the
+// generated types and fields are compared so your customization of the schema is ignored, types and fields which look
+// generated but are not generated anymore (e.g. of a removed column) are reported as well. It returns an unified diff
+// which is empty if the schema is up to date.
+func Check(config Config, filename string, options MergeOptions) (string, error) {
+	if !fileExists(filename) {
+		return "", fmt.Errorf("%v does not exist, generate it first", filename)
+	}
+	currentSchema, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return "", fmt.Errorf("could not read current schema %v: %v", filename, err)
+	}
+
+	if config.Deprecation != nil && config.Deprecation.PreviousSchema == "" {
+		deprecation := *config.Deprecation
+		deprecation.PreviousSchema = string(currentSchema)
+		config.Deprecation = &deprecation
+	}
+	document, err := Generate(config)
+	if err != nil {
+		return "", err
+	}
+
+	var formattedCurrentSchema, formattedSchema string
+	if options.MergeSchema {
+		formattedCurrentSchema, formattedSchema, err = formatGeneratedDefinitions(string(currentSchema), document.SDL)
+	} else {
+		formattedCurrentSchema, err = formatContent(filename, string(currentSchema))
+		if err == nil {
+			formattedSchema, err = formatContent(filename, document.SDL)
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if formattedCurrentSchema == formattedSchema {
+		return "", nil
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(formattedCurrentSchema),
+		B:        difflib.SplitLines(formattedSchema),
+		FromFile: filename,
+		ToFile:   filename + " (generated)",
+		Context:  3,
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not diff schemas: %v", err)
+	}
+	return diff, nil
+}
+
+// formatGeneratedDefinitions formats the generated schema and the part of the current schema which is generated, types
+// and fields which are only in the current schema are your customization which the merge keeps so they are left out
+// unless they look generated e.g. a field of a removed column or the types of a dropped table
+func formatGeneratedDefinitions(currentSchema string, generatedSchema string) (string, string, error) {
+	currentDoc, err := parser.ParseSchema(&ast.Source{Name: "current schema", Input: currentSchema})
+	if err != nil {
+		return "", "", fmt.Errorf("could not parse current schema: %v", err)
+	}
+	generatedDoc, err := parser.ParseSchema(&ast.Source{Name: "generated schema", Input: generatedSchema})
+	if err != nil {
+		return "", "", fmt.Errorf("could not parse generated schema: %v", err)
+	}
+	return formatSchemaDocument(getGeneratedPart(currentDoc, generatedDoc)), formatSchemaDocument(generatedDoc), nil
+}
+
+// getGeneratedPart returns the part of the current schema which is generated or looks generated
+func getGeneratedPart(currentDoc *ast.SchemaDocument, generatedDoc *ast.SchemaDocument) *ast.SchemaDocument {
+	g := &generatedDefinitions{
+		current:   append(append(ast.DefinitionList{}, currentDoc.Definitions...), currentDoc.Extensions...),
+		generated: append(append(ast.DefinitionList{}, generatedDoc.Definitions...), generatedDoc.Extensions...),
+	}
+	// e.g. User of input UserWhere, every model has a where input
+	for _, definition := range g.current {
+		if definition.Kind == ast.InputObject && strings.HasSuffix(definition.Name, "Where") &&
+			definition.Name != "Where" {
+			g.models = append(g.models, strings.TrimSuffix(definition.Name, "Where"))
+		}
+	}
+
+	generatedPart := &ast.SchemaDocument{
+		Definitions: g.getDefinitions(currentDoc.Definitions, generatedDoc.Definitions),
+		Extensions:  g.getDefinitions(currentDoc.Extensions, generatedDoc.Extensions),
+	}
+	if len(generatedDoc.Schema) > 0 {
+		generatedPart.Schema = currentDoc.Schema
+	}
+	if len(generatedDoc.SchemaExtension) > 0 {
+		generatedPart.SchemaExtension = currentDoc.SchemaExtension
+	}
+	for _, directive := range generatedDoc.Directives {
+		if currentDirective := currentDoc.Directives.ForName(directive.Name); currentDirective != nil {
+			generatedPart.Directives = append(generatedPart.Directives, currentDirective)
+		}
+	}
+	return generatedPart
+}
+
+// generatedInputSuffixes are the suffixes of inputs which only have generated fields
+var generatedInputSuffixes = []string{ //nolint:gochecknoglobals
+	"Where", "Filter", "CreateInput", "UpdateInput", "BatchUpdateItem",
+}
+
+// generatedTypeSuffixes are the suffixes of the generated types of a model e.g. UserPayload or UsersDeletePayload
+var generatedTypeSuffixes = append([]string{"Payload", "ListItem", "Result", "Error"}, //nolint:gochecknoglobals
+	generatedInputSuffixes...)
+
+type generatedDefinitions struct {
+	current   ast.DefinitionList // definitions and extensions of the current schema
+	generated ast.DefinitionList // definitions and extensions of the generated schema
+	models    []string           // names of the models in the current schema
+}
+
+// getDefinitions returns the current definitions with only the fields and enum values which are generated, in the
+// order of the generated definitions, followed by the definitions and fields which look generated but are not
+// generated anymore
+func (g *generatedDefinitions) getDefinitions(
+	current ast.DefinitionList,
+	generated ast.DefinitionList,
+) ast.DefinitionList {
+	var definitions ast.DefinitionList
+	for _, generatedDefinition := range generated {
+		currentDefinition := current.ForName(generatedDefinition.Name)
+		if currentDefinition == nil {
+			continue
+		}
+		definition := *currentDefinition
+		definition.Fields = nil
+		for _, field := range generatedDefinition.Fields {
+			if currentField := currentDefinition.Fields.ForName(field.Name); currentField != nil {
+				definition.Fields = append(definition.Fields, currentField)
+			}
+		}
+		for _, field := range currentDefinition.Fields {
+			if generatedDefinition.Fields.ForName(field.Name) == nil && g.isRemovedField(currentDefinition, field) {
+				definition.Fields = append(definition.Fields, field)
+			}
+		}
+		definition.EnumValues = nil
+		for _, value := range generatedDefinition.EnumValues {
+			if currentValue := currentDefinition.EnumValues.ForName(value.Name); currentValue != nil {
+				definition.EnumValues = append(definition.EnumValues, currentValue)
+			}
+		}
+		definitions = append(definitions, &definition)
+	}
+	for _, definition := range current {
+		if generated.ForName(definition.Name) != nil {
+			continue
+		}
+		if g.isRemovedType(definition.Name) {
+			definitions = append(definitions, definition)
+			continue
+		}
+		// e.g. extend type Query { groups: [Group!]! } of a dropped table
+		if generatedDefinition := g.generated.ForName(definition.Name); generatedDefinition != nil {
+			removedFields := *definition
+			removedFields.Fields = nil
+			for _, field := range definition.Fields {
+				if generatedDefinition.Fields.ForName(field.Name) == nil && g.isRemovedField(definition, field) {
+					removedFields.Fields = append(removedFields.Fields, field)
+				}
+			}
+			if len(removedFields.Fields) > 0 {
+				definitions = append(definitions, &removedFields)
+			}
+		}
+	}
+	return definitions
+}
+
+// isRemovedType returns true for types which look generated but are not generated anymore e.g. the types of a
+// dropped table or the where input of a renamed model
+func (g *generatedDefinitions) isRemovedType(name string) bool {
+	if g.generated.ForName(name) != nil {
+		return false
+	}
+	for _, model := range g.models {
+		if name == model {
+			return true
+		}
+		if !strings.HasPrefix(name, model) {
+			continue
+		}
+		for _, suffix := range generatedTypeSuffixes {
+			if strings.HasSuffix(name, suffix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isRemovedField returns true for fields which look generated but are not generated anymore e.g. the fields of a
+// removed column which are in the where input of the model or the queries of a dropped table
+func (g *generatedDefinitions) isRemovedField(definition *ast.Definition, field *ast.FieldDefinition) bool {
+	if definition.Kind == ast.InputObject {
+		for _, suffix := range generatedInputSuffixes {
+			if strings.HasSuffix(definition.Name, suffix) {
+				return true
+			}
+		}
+	}
+	if where := g.current.ForName(definition.Name + "Where"); where != nil && where.Fields.ForName(field.Name) != nil {
+		return true
+	}
+	if definition.Name == "Query" || definition.Name == "Mutation" {
+		if g.isRemovedType(field.Type.Name()) {
+			return true
+		}
+		for _, argument := range field.Arguments {
+			if g.isRemovedType(argument.Type.Name()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func formatSchemaDocument(doc *ast.SchemaDocument) string {
+	var s strings.Builder
+	formatter.NewFormatter(&s).FormatSchemaDocument(doc)
+	return s.String()
+}
+
+// formatContent formats content with prettier without touching the filesystem, filename is only used by prettier
+// to infer the parser
+func formatContent(filename string, content string) (string, error) {
+	name := "prettier"
+	args := []string{"--stdin-filepath", filename}
+
+	var stderr bytes.Buffer
+	cmd := exec.Command(name, args...)
+	cmd.Stdin = strings.NewReader(content)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("executing command: '%v %v' failed with: %v, output: %v", name, strings.Join(args, " "), err,
+			stderr.String())
+	}
+	return string(out), nil
+}
@@ -0,0 +1,103 @@
+package schema
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCheckMerge(t *testing.T) {
+	config := Config{ModelDirectory: filepath.Join("testdata", "social-network"), Mutations: true}
+	document, err := Generate(config)
+	if err != nil {
+		t.Fatalf("could not generate schema: %v", err)
+	}
+
+	directory, err := ioutil.TempDir("", "check")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+	filename := filepath.Join(directory, "schema.graphql")
+
+	// customization which the merge keeps
+	customizedSchema := strings.Replace(document.SDL, "type User {", "type User {\n\tfullName: String!", 1) +
+		"type Stats {\n\tusers: Int!\n}\n\nextend type Query {\n\tstats: Stats!\n}\n"
+	tests := []struct {
+		name        string
+		schema      string
+		upToDate    bool
+		diffStrings []string
+	}{
+		{name: "generated", schema: document.SDL, upToDate: true},
+		{name: "customized", schema: customizedSchema, upToDate: true},
+		{name: "missing field", schema: strings.Replace(customizedSchema, "\temail: String!\n", "", 1),
+			diffStrings: []string{"+\temail: String!"}},
+		{name: "missing type", schema: strings.Replace(customizedSchema, "input UserWhere {", "input OldWhere {", 1),
+			diffStrings: []string{"+input UserWhere {", "-input OldWhere {"}},
+		{name: "removed column", schema: strings.NewReplacer("type User {", "type User {\n\tnickname: String",
+			"input UserWhere {", "input UserWhere {\n\tnickname: StringFilter").Replace(customizedSchema),
+			diffStrings: []string{"-\tnickname: String\n", "-\tnickname: StringFilter"}},
+		{name: "dropped table", schema: customizedSchema + "type Group {\n\tid: ID!\n}\n\ninput GroupWhere {\n\t" +
+			"id: IDFilter\n}\n\ntype GroupsDeletePayload {\n\tids: [ID!]!\n}\n\nextend type Query {\n\t" +
+			"groups(filter: GroupFilter): [Group!]!\n}\n",
+			diffStrings: []string{"-type Group {", "-input GroupWhere {", "-type GroupsDeletePayload {",
+				"-\tgroups(filter: GroupFilter): [Group!]!"}},
+	}
+	for _, test := range tests {
+		if err := ioutil.WriteFile(filename, []byte(test.schema), 0644); err != nil { //nolint:gosec
+			t.Fatal(err)
+		}
+		diff, err := Check(config, filename, MergeOptions{MergeSchema: true})
+		if err != nil {
+			t.Fatalf("%v: could not check schema: %v", test.name, err)
+		}
+		if test.upToDate && diff != "" {
+			t.Errorf("%v: expected schema to be up to date but got diff\n%v", test.name, diff)
+		}
+		for _, diffString := range test.diffStrings {
+			if !strings.Contains(diff, diffString) {
+				t.Errorf("%v: expected diff to contain %q but got\n%v", test.name, diffString, diff)
+			}
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	config := Config{ModelDirectory: filepath.Join("testdata", "tree")}
+	document := generateSchema(t, config)
+
+	directory, err := ioutil.TempDir("", "check")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+	defer fakePrettier(t, directory)()
+	filename := filepath.Join(directory, "schema.graphql")
+
+	if _, err := Check(config, filename, MergeOptions{}); err == nil {
+		t.Error("expected an error if the schema has not been generated")
+	}
+
+	if err := ioutil.WriteFile(filename, []byte(document.SDL), 0644); err != nil { //nolint:gosec
+		t.Fatal(err)
+	}
+	if diff, err := Check(config, filename, MergeOptions{}); err != nil || diff != "" {
+		t.Errorf("expected the generated schema to be up to date but got diff %v, error: %v", diff, err)
+	}
+
+	// without merging customization is a difference as well
+	customizedSchema := strings.Replace(document.SDL, "type Category {", "type Category {\n\tpath: String!", 1)
+	if err := ioutil.WriteFile(filename, []byte(customizedSchema), 0644); err != nil { //nolint:gosec
+		t.Fatal(err)
+	}
+	diff, err := Check(config, filename, MergeOptions{})
+	if err != nil {
+		t.Fatalf("could not check schema: %v", err)
+	}
+	if !strings.Contains(diff, "-\tpath: String!") || !strings.Contains(diff, filename+" (generated)") {
+		t.Errorf("expected diff to remove the customized field but got\n%v", diff)
+	}
+}
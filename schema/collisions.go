@@ -0,0 +1,189 @@
+package schema
+
+import (
+	"fmt"
+	"sort"
+)
+
+// typeNameSource is where a generated type name comes from e.g. the payload of model User
+type typeNameSource struct {
+	Model       *Model // nil for types which are always generated e.g. StringFilter
+	Description string
+}
+
+// typeNameRegistry contains every type name which will be generated together with their sources, a name with more
+// than one source is a collision
+type typeNameRegistry map[string][]*typeNameSource
+
+func (r typeNameRegistry) add(name string, model *Model, description string) {
+	r[name] = append(r[name], &typeNameSource{Model: model, Description: description})
+}
+
+// collisions returns the colliding type names in alphabetical order
+func (r typeNameRegistry) collisions() []string {
+	var names []string
+	for name, sources := range r {
+		if len(sources) > 1 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// getTypeNameRegistry registers the type names in the same way as getSchema generates them
+func getTypeNameRegistry(allModels []*Model, config Config) typeNameRegistry {
+	r := typeNameRegistry{}
+	for _, model := range allModels {
+		if model.IsExternal {
+			r.add(model.Name, model, "the entity stub of model "+model.Name)
+		}
+	}
+	models := getLocalModels(allModels)
+	for _, scalar := range []string{"ID", "String", "Int", "Float", "Boolean"} {
+		r.add(scalar, nil, "the built-in scalar "+scalar)
+	}
+	for _, helper := range []string{"IDFilter", "StringFilter", "IntFilter", "FloatFilter", "BooleanFilter"} {
+		r.add(helper, nil, "the filter helper "+helper)
+	}
+	for _, elementType := range getArrayFilterTypes(models) {
+		r.add(elementType+"ArrayFilter", nil, "the array filter helper "+elementType+"ArrayFilter")
+	}
+	for _, searchType := range getSearchTypeNames(models, config) {
+		r.add(searchType, nil, "the search type "+searchType)
+	}
+	if hasBinaryFields(models) {
+		for _, scalar := range getBinaryScalars(config) {
+			r.add(scalar, nil, "the binary scalar "+scalar)
+		}
+	}
+	if hasJSONFields(models) {
+		r.add(getJSONScalar(config), nil, "the json scalar "+getJSONScalar(config))
+		r.add(getJSONScalar(config)+"Filter", nil, "the json filter helper "+getJSONScalar(config)+"Filter")
+	}
+	for _, scalar := range getCustomTypeScalars(models, config) {
+		r.add(scalar, nil, "the custom type scalar "+scalar)
+	}
+	r.add("Query", nil, "the Query type")
+	if config.Mutations {
+		r.add("Mutation", nil, "the Mutation type")
+		for _, errorType := range mutationErrorTypes[config.MutationErrors] {
+			r.add(errorType, nil, "the mutation error "+errorType)
+		}
+	}
+
+	for _, model := range models {
+		of := " of model " + model.Name
+		r.add(model.Name, model, "model "+model.Name)
+		if hasListItemType(model, config) {
+			r.add(model.Name+"ListItem", model, "the list item"+of)
+		}
+		r.add(model.Name+"Filter", model, "the filter"+of)
+		r.add(model.Name+"Where", model, "the where input"+of)
+		if config.Pagination == "offset" {
+			r.add(model.Name+"Pagination", model, "the pagination"+of)
+		}
+		if !config.Mutations {
+			continue
+		}
+		hasCreateInput := len(getCreateInputFields(model, config)) > 0
+		hasUpdateInput := len(getUpdateInputFields(model, config)) > 0
+		if hasCreateInput {
+			r.add(model.Name+"CreateInput", model, "the create input"+of)
+		}
+		if hasUpdateInput {
+			r.add(model.Name+"UpdateInput", model, "the update input"+of)
+		}
+		r.add(model.Name+"Payload", model, "the payload"+of)
+		r.add(model.Name+"DeletePayload", model, "the delete payload"+of)
+		if config.MutationErrors == mutationErrorsUnion {
+			for _, result := range getMutationResults(model, hasCreateInput, hasUpdateInput, config) {
+				r.add(result.Name, model, "the mutation result"+of)
+			}
+		}
+		if config.BatchCreate && hasCreateInput {
+			r.add(model.PluralName+"CreateInput", model, "the batch create input"+of)
+			r.add(model.PluralName+"Payload", model, "the batch create payload"+of)
+		}
+		if config.BatchUpdate && hasUpdateInput {
+			r.add(model.PluralName+"UpdatePayload", model, "the batch update payload"+of)
+			r.add(model.Name+"BatchUpdateItem", model, "the batch update item"+of)
+			r.add(model.PluralName+"BatchUpdatePayload", model, "the batch update by ids payload"+of)
+		}
+		if config.BatchDelete {
+			r.add(model.PluralName+"DeletePayload", model, "the batch delete payload"+of)
+		}
+		if config.BatchDelete && hasSoftDelete(model) {
+			r.add(model.PluralName+"RestorePayload", model, "the batch restore payload"+of)
+		}
+	}
+	return r
+}
+
+// resolveTypeNameCollisions renames models of which a generated type collides with another generated type e.g. a
+// table user_payloads results in the type UserPayload which is also the payload of model User. Models are renamed
+// with the type name prefix and suffix of the config, without them a collision is an error.
+func resolveTypeNameCollisions(models []*Model, config Config) error {
+	renamed := map[*Model]bool{}
+	for {
+		registry := getTypeNameRegistry(models, config)
+		collisions := registry.collisions()
+		if len(collisions) == 0 {
+			return nil
+		}
+
+		// one at a time since renaming a model could resolve other collisions as well
+		name := collisions[0]
+		sources := registry[name]
+		if config.TypeNamePrefix == "" && config.TypeNameSuffix == "" {
+			return fmt.Errorf("type %v is generated for both %v and %v, rename the table or set a type name "+
+				"prefix or suffix for colliding models", name, sources[0].Description, sources[1].Description)
+		}
+
+		model := getModelToRename(name, sources, renamed)
+		if model == nil {
+			return fmt.Errorf("type %v is generated for both %v and %v and could not be resolved by renaming "+
+				"the model", name, sources[0].Description, sources[1].Description)
+		}
+		oldName := model.Name
+		renameModel(models, model, config.TypeNamePrefix+model.Name+config.TypeNameSuffix,
+			config.TypeNamePrefix+model.PluralName+config.TypeNameSuffix)
+		renamed[model] = true
+		fmt.Printf("[warn] renamed model %v to %v since type %v is generated for both %v and %v\n", oldName,
+			model.Name, name, sources[0].Description, sources[1].Description)
+	}
+}
+
+// getModelToRename returns the model of which the name is the colliding type itself (e.g. UserPayload instead of User)
+// or else the model with the longest name, helpers and models which are already renamed can not be renamed
+func getModelToRename(name string, sources []*typeNameSource, renamed map[*Model]bool) *Model {
+	var result *Model
+	for _, source := range sources {
+		model := source.Model
+		if model == nil || renamed[model] {
+			continue
+		}
+		if model.Name == name {
+			return model
+		}
+		if result == nil || len(model.Name) > len(result.Name) {
+			result = model
+		}
+	}
+	return result
+}
+
+// renameModel renames the model and the relationships to the model
+func renameModel(models []*Model, model *Model, name string, pluralName string) {
+	oldName := model.Name
+	model.Name = name
+	model.PluralName = pluralName
+	for _, m := range models {
+		for _, field := range m.Fields {
+			if field.RelationType == oldName && field.BoilerField.Relationship != nil {
+				field.RelationType = name
+				field.RelationFullType = getFullType(name, field.BoilerField.IsArray, field.BoilerField.IsRequired)
+			}
+		}
+	}
+}
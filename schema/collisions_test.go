@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	gqlgen_sqlboiler "github.com/web-ridge/gqlgen-sqlboiler/v2"
+)
+
+// getCollidingModels returns the models of the tables query, users, user_payloads and string_filters where users has a
+// relationship to user_payloads
+func getCollidingModels() []*Model {
+	newModel := func(name string, pluralName string) *Model {
+		return &Model{
+			Name:       name,
+			PluralName: pluralName,
+			Fields: []*Field{{
+				Name:             "id",
+				Type:             "ID",
+				FullType:         "ID!",
+				FullTypeOptional: "ID",
+				IsPrimaryKey:     true,
+				BoilerField:      &gqlgen_sqlboiler.BoilerField{Name: "ID"},
+			}, {
+				Name:             "name",
+				Type:             "String",
+				FullType:         "String!",
+				FullTypeOptional: "String",
+				BoilerField:      &gqlgen_sqlboiler.BoilerField{Name: "Name"},
+			}},
+		}
+	}
+	user := newModel("User", "Users")
+	user.Fields = append(user.Fields, &Field{
+		RelationName:     "userPayload",
+		RelationType:     "UserPayload",
+		RelationFullType: "UserPayload",
+		BoilerField: &gqlgen_sqlboiler.BoilerField{
+			Name:         "UserPayload",
+			IsRelation:   true,
+			Relationship: &gqlgen_sqlboiler.BoilerModel{Name: "UserPayload"},
+		},
+	})
+	return []*Model{newModel("Query", "Queries"), user, newModel("UserPayload", "UserPayloads"),
+		newModel("StringFilter", "StringFilters")}
+}
+
+func TestResolveTypeNameCollisions(t *testing.T) {
+	config := Config{Mutations: true, BatchCreate: true, BatchUpdate: true, BatchDelete: true}
+
+	err := resolveTypeNameCollisions(getCollidingModels(), config)
+	expectedError := "type Query is generated for both the Query type and model Query"
+	if err == nil || !strings.Contains(err.Error(), expectedError) {
+		t.Errorf("expected error %v but got %v", expectedError, err)
+	}
+
+	config.TypeNamePrefix = "Db"
+	models := getCollidingModels()
+	if err := resolveTypeNameCollisions(models, config); err != nil {
+		t.Fatalf("could not resolve collisions: %v", err)
+	}
+	expectedNames := []string{"DbQuery", "User", "DbUserPayload", "DbStringFilter"}
+	for i, model := range models {
+		if model.Name != expectedNames[i] {
+			t.Errorf("expected model %v to be renamed to %v", model.Name, expectedNames[i])
+		}
+	}
+	if relation := models[1].Fields[2]; relation.RelationType != "DbUserPayload" {
+		t.Errorf("expected relationship to be renamed to DbUserPayload but got %v", relation.RelationType)
+	}
+
+	schema := getSchema(models, config)
+	if _, err := gqlparser.LoadSchema(&ast.Source{Name: "schema.graphql", Input: schema}); err != nil {
+		t.Errorf("generated schema is invalid: %v", err)
+	}
+}
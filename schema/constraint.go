@@ -0,0 +1,162 @@
+package schema
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const constraintDirective = "directive @constraint(minLength: Int, maxLength: Int, min: Float, max: Float, " +
+	"pattern: String) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION"
+
+var ( //nolint:gochecknoglobals
+	typeCastRegexp   = regexp.MustCompile(`::[a-zA-Z_]+( varying)?(\[\])?`)
+	orRegexp         = regexp.MustCompile(`(?i)\sOR\s`)
+	andRegexp        = regexp.MustCompile(`(?i)\s+AND\s+`)
+	checkRegexp      = regexp.MustCompile(`(?i)^\s*CHECK\s*`)
+	comparisonRegexp = regexp.MustCompile(`^(#length|#column)\s*(>=|<=|>|<)\s*(-?[\d.]+)$`)
+)
+
+// constraint are the validation rules of an input field based on the column e.g. @constraint(maxLength: 255), empty
+// rules are not set
+type constraint struct {
+	MinLength string
+	MaxLength string
+	Min       string
+	Max       string
+	Pattern   string
+}
+
+// String returns the directive e.g. @constraint(minLength: 4, maxLength: 255) or an empty string without rules
+func (c *constraint) String() string {
+	var arguments []string
+	for _, argument := range []struct {
+		name  string
+		value string
+	}{{"minLength", c.MinLength}, {"maxLength", c.MaxLength}, {"min", c.Min}, {"max", c.Max}} {
+		if argument.value != "" {
+			arguments = append(arguments, argument.name+": "+argument.value)
+		}
+	}
+	if c.Pattern != "" {
+		arguments = append(arguments, `pattern: "`+strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(c.Pattern)+`"`)
+	}
+	if len(arguments) == 0 {
+		return ""
+	}
+	return "@constraint(" + strings.Join(arguments, ", ") + ")"
+}
+
+// getConstraint returns the constraint of a field based on the max length and check constraints of the column
+func getConstraint(field *Field) *constraint {
+	c := &constraint{}
+	if field.Column == nil || field.BoilerField.IsRelation {
+		return c
+	}
+	if field.Column.MaxLength > 0 && field.Type == "String" {
+		c.MaxLength = strconv.Itoa(field.Column.MaxLength)
+	}
+	for _, checkConstraint := range field.Column.CheckConstraints {
+		c.addCheckConstraint(field.Column.Name, field.Type == "Int", checkConstraint)
+	}
+	return c
+}
+
+// addCheckConstraint adds the rules of simple check constraints e.g. CHECK (length(email) > 3), CHECK (age >= 0 AND
+// age <= 150), CHECK (rating BETWEEN 0 AND 5) or CHECK (email ~ '^.+@.+$'), other expressions are ignored
+func (c *constraint) addCheckConstraint(column string, isInteger bool, checkConstraint string) {
+	expression := checkRegexp.ReplaceAllString(checkConstraint, "")
+	expression = typeCastRegexp.ReplaceAllString(expression, "")
+	if orRegexp.MatchString(expression) {
+		return
+	}
+	quotedColumn := `["` + "`" + `]?` + regexp.QuoteMeta(column) + `["` + "`" + `]?`
+
+	// postgres regular expressions could contain parentheses so they are taken out first
+	patternRegexp := regexp.MustCompile(`(^|[^\w])` + quotedColumn + `\s*~\s*'((?:[^']|'')*)'`)
+	if match := patternRegexp.FindStringSubmatch(expression); match != nil {
+		c.Pattern = strings.ReplaceAll(match[2], "''", "'")
+		expression = strings.Replace(expression, match[0], match[1], 1)
+	}
+
+	// e.g. length(email) or char_length((email)::text) of postgres
+	lengthRegexp := regexp.MustCompile(`(?i)\b(char_length|length|len)\s*\(\s*\(?\s*` + quotedColumn + `\s*\)?\s*\)`)
+	expression = lengthRegexp.ReplaceAllString(expression, "#length")
+	columnRegexp := regexp.MustCompile(`(^|[^\w#])` + quotedColumn + `($|[^\w])`)
+	expression = columnRegexp.ReplaceAllString(expression, "$1#column$2")
+	expression = strings.NewReplacer("(", " ", ")", " ").Replace(expression)
+
+	betweenRegexp := regexp.MustCompile(`(?i)(#length|#column)\s+BETWEEN\s+(-?[\d.]+)\s+AND\s+(-?[\d.]+)`)
+	for _, match := range betweenRegexp.FindAllStringSubmatch(expression, -1) {
+		c.addComparison(match[1], ">=", match[2], isInteger)
+		c.addComparison(match[1], "<=", match[3], isInteger)
+	}
+	expression = betweenRegexp.ReplaceAllString(expression, "")
+
+	for _, part := range andRegexp.Split(expression, -1) {
+		if match := comparisonRegexp.FindStringSubmatch(strings.TrimSpace(part)); match != nil {
+			c.addComparison(match[1], match[2], match[3], isInteger)
+		}
+	}
+}
+
+// addComparison adds a comparison of the length (#length) or the value (#column) of the column e.g. #length > 3
+// results in minLength: 4, exclusive comparisons of decimals can not be expressed
+func (c *constraint) addComparison(subject string, operator string, value string, isInteger bool) {
+	if operator == ">" || operator == "<" {
+		if subject != "#length" && !isInteger {
+			return
+		}
+		number, err := strconv.Atoi(value)
+		if err != nil {
+			return
+		}
+		if operator == ">" {
+			value, operator = strconv.Itoa(number+1), ">="
+		} else {
+			value, operator = strconv.Itoa(number-1), "<="
+		}
+	}
+
+	switch {
+	case subject == "#length" && operator == ">=":
+		c.MinLength = value
+	case subject == "#length" && operator == "<=":
+		// the check constraint could be stricter than the length of the column type
+		if c.MaxLength == "" || atoi(value) < atoi(c.MaxLength) {
+			c.MaxLength = value
+		}
+	case operator == ">=":
+		c.Min = value
+	case operator == "<=":
+		c.Max = value
+	}
+}
+
+// hasConstraints returns true if an input field of the models has a constraint
+func hasConstraints(models []*Model, config Config) bool {
+	for _, model := range models {
+		for _, field := range append(getCreateInputFields(model, config), getUpdateInputFields(model, config)...) {
+			if getConstraint(field).String() != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getConstraintDirective returns the constraint directive of an input field e.g. " @constraint(maxLength: 255)"
+func getConstraintDirective(field *Field, config Config) string {
+	if !config.ConstraintDirectives {
+		return ""
+	}
+	if directive := getConstraint(field).String(); directive != "" {
+		return " " + directive
+	}
+	return ""
+}
+
+func atoi(s string) int {
+	i, _ := strconv.Atoi(s)
+	return i
+}
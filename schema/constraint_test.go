@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"testing"
+
+	gqlgen_sqlboiler "github.com/web-ridge/gqlgen-sqlboiler/v2"
+)
+
+func TestGetConstraint(t *testing.T) {
+	tests := []struct {
+		column           string
+		fieldType        string
+		maxLength        int
+		checkConstraints []string
+		expected         string
+	}{
+		{"email", "String", 255, []string{"CHECK (length(email) > 3)"}, "@constraint(minLength: 4, maxLength: 255)"},
+		{"code", "String", 10, []string{"CHECK ((char_length((code)::text) <= 6))"}, "@constraint(maxLength: 6)"},
+		{"age", "Int", 0, []string{"CHECK (age >= 0 AND age <= 150)"}, "@constraint(min: 0, max: 150)"},
+		{"age", "Int", 0, []string{"CHECK (`age` > 0)"}, "@constraint(min: 1)"},
+		{"rating", "Float", 0, []string{"CHECK (rating BETWEEN 0 AND 5)"}, "@constraint(min: 0, max: 5)"},
+		{"price", "Float", 0, []string{"CHECK (price > 0)"}, ""},
+		{"email", "String", 0, []string{`CHECK ((email ~ '^[^@]+@[^@]+\.\w+$'::text))`},
+			`@constraint(pattern: "^[^@]+@[^@]+\\.\\w+$")`},
+		{"age", "Int", 0, []string{"CHECK (age IS NULL OR age >= 18)"}, ""},
+		// rules of other columns in the same check constraint are not added
+		{"starts_at", "Int", 0, []string{"CHECK (ends_at > starts_at AND starts_at >= 0)"}, "@constraint(min: 0)"},
+	}
+	for _, test := range tests {
+		field := &Field{
+			Type:        test.fieldType,
+			BoilerField: &gqlgen_sqlboiler.BoilerField{},
+			Column: &Column{
+				Name:             test.column,
+				MaxLength:        test.maxLength,
+				CheckConstraints: test.checkConstraints,
+			},
+		}
+		if directive := getConstraint(field).String(); directive != test.expected {
+			t.Errorf("expected %v for %v but got %v", test.expected, test.checkConstraints, directive)
+		}
+	}
+}
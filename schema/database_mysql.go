@@ -0,0 +1,155 @@
+package schema
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+func introspectMySQL(db *sql.DB) ([]*dbTable, error) {
+	tables, err := introspectMySQLTables(db)
+	if err != nil {
+		return nil, err
+	}
+	if err := introspectMySQLColumns(db, tables); err != nil {
+		return nil, err
+	}
+	if err := introspectMySQLForeignKeys(db, tables); err != nil {
+		return nil, err
+	}
+	// check constraints are only available since MySQL 8.0.16
+	if err := introspectMySQLCheckConstraints(db, tables); err != nil {
+		fmt.Println("[warn] could not read check constraints, they are only supported since MySQL 8.0.16: ", err)
+	}
+	return tables, nil
+}
+
+func introspectMySQLTables(db *sql.DB) ([]*dbTable, error) {
+	rows, err := db.Query(`
+		SELECT TABLE_NAME, TABLE_COMMENT
+		FROM information_schema.TABLES
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_TYPE = 'BASE TABLE'
+		ORDER BY TABLE_NAME`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tables []*dbTable
+	for rows.Next() {
+		table := &dbTable{}
+		if err := rows.Scan(&table.Name, &table.Comment); err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+	return tables, rows.Err()
+}
+
+func introspectMySQLColumns(db *sql.DB, tables []*dbTable) error {
+	rows, err := db.Query(`
+		SELECT
+			TABLE_NAME,
+			COLUMN_NAME,
+			COLUMN_TYPE,
+			IS_NULLABLE = 'YES',
+			COLUMN_DEFAULT,
+			COALESCE(CHARACTER_MAXIMUM_LENGTH, 0),
+			COLUMN_COMMENT,
+			COLUMN_KEY,
+			EXTRA
+		FROM information_schema.COLUMNS
+		WHERE TABLE_SCHEMA = DATABASE()
+		ORDER BY TABLE_NAME, ORDINAL_POSITION`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tableName, columnKey, extra string
+		var defaultValue sql.NullString
+		column := &Column{}
+		if err := rows.Scan(&tableName, &column.Name, &column.DBType, &column.IsNullable, &defaultValue,
+			&column.MaxLength, &column.Comment, &columnKey, &extra); err != nil {
+			return err
+		}
+		column.Default = defaultValue.String
+		column.HasDefault = defaultValue.Valid || extra == "auto_increment"
+		column.IsPrimaryKey = columnKey == "PRI"
+		column.IsUnique = columnKey == "UNI"
+		if table := findTable(tables, tableName); table != nil {
+			table.Columns = append(table.Columns, column)
+		}
+	}
+	return rows.Err()
+}
+
+func introspectMySQLForeignKeys(db *sql.DB, tables []*dbTable) error {
+	rows, err := db.Query(`
+		SELECT TABLE_NAME, CONSTRAINT_NAME, COLUMN_NAME, REFERENCED_TABLE_NAME, REFERENCED_COLUMN_NAME
+		FROM information_schema.KEY_COLUMN_USAGE
+		WHERE TABLE_SCHEMA = DATABASE() AND REFERENCED_TABLE_NAME IS NOT NULL
+		ORDER BY TABLE_NAME, CONSTRAINT_NAME, ORDINAL_POSITION`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	// a foreign key on multiple columns has a row per column
+	foreignKeys := map[string][]*dbForeignKey{}
+	var constraints []string
+	for rows.Next() {
+		var tableName, constraintName string
+		foreignKey := &dbForeignKey{}
+		if err := rows.Scan(&tableName, &constraintName, &foreignKey.Column, &foreignKey.ForeignTable,
+			&foreignKey.ForeignColumn); err != nil {
+			return err
+		}
+		constraint := tableName + "." + constraintName
+		if len(foreignKeys[constraint]) == 0 {
+			constraints = append(constraints, constraint)
+		}
+		foreignKeys[constraint] = append(foreignKeys[constraint], foreignKey)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	// relationships are only supported for foreign keys on a single column
+	for _, constraint := range constraints {
+		if len(foreignKeys[constraint]) != 1 {
+			continue
+		}
+		tableName := strings.SplitN(constraint, ".", 2)[0]
+		if table := findTable(tables, tableName); table != nil {
+			table.ForeignKeys = append(table.ForeignKeys, foreignKeys[constraint][0])
+		}
+	}
+	return nil
+}
+
+func introspectMySQLCheckConstraints(db *sql.DB, tables []*dbTable) error {
+	rows, err := db.Query(`
+		SELECT tc.TABLE_NAME, cc.CHECK_CLAUSE
+		FROM information_schema.TABLE_CONSTRAINTS tc
+		JOIN information_schema.CHECK_CONSTRAINTS cc
+			ON cc.CONSTRAINT_SCHEMA = tc.CONSTRAINT_SCHEMA AND cc.CONSTRAINT_NAME = tc.CONSTRAINT_NAME
+		WHERE tc.TABLE_SCHEMA = DATABASE() AND tc.CONSTRAINT_TYPE = 'CHECK'
+		ORDER BY tc.TABLE_NAME, tc.CONSTRAINT_NAME`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tableName, checkClause string
+		if err := rows.Scan(&tableName, &checkClause); err != nil {
+			return err
+		}
+		if table := findTable(tables, tableName); table != nil {
+			addCheckConstraint(table, "CHECK ("+checkClause+")")
+		}
+	}
+	return rows.Err()
+}
@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"database/sql/driver"
+	"testing"
+)
+
+func TestIntrospectMySQL(t *testing.T) {
+	db := openFakeDatabase(t, []*fakeResult{
+		{Query: "information_schema.TABLES", Columns: []string{"TABLE_NAME", "TABLE_COMMENT"}, Rows: [][]driver.Value{
+			{"comments", ""}, {"posts", "blog posts"},
+		}},
+		{Query: "information_schema.COLUMNS", Columns: []string{"TABLE_NAME", "COLUMN_NAME", "COLUMN_TYPE",
+			"IS_NULLABLE", "COLUMN_DEFAULT", "CHARACTER_MAXIMUM_LENGTH", "COLUMN_COMMENT", "COLUMN_KEY",
+			"EXTRA"}, Rows: [][]driver.Value{
+			{"comments", "id", "int unsigned", int64(0), nil, int64(0), "", "PRI", "auto_increment"},
+			{"comments", "post", "int unsigned", int64(0), nil, int64(0), "", "MUL", ""},
+			{"comments", "post_version", "int", int64(0), nil, int64(0), "", "", ""},
+			{"posts", "id", "int unsigned", int64(0), nil, int64(0), "", "PRI", "auto_increment"},
+			{"posts", "slug", "varchar(140)", int64(0), nil, int64(140), "used in urls", "UNI", ""},
+			{"posts", "status", "enum('draft','published')", int64(1), "draft", int64(9), "", "", ""},
+		}},
+		{Query: "information_schema.KEY_COLUMN_USAGE", Columns: []string{"TABLE_NAME", "CONSTRAINT_NAME",
+			"COLUMN_NAME", "REFERENCED_TABLE_NAME", "REFERENCED_COLUMN_NAME"}, Rows: [][]driver.Value{
+			{"comments", "comments_post_fk", "post", "posts", "id"},
+			{"comments", "comments_version_fk", "post", "post_versions", "post_id"},
+			{"comments", "comments_version_fk", "post_version", "post_versions", "version"},
+		}},
+		{Query: "information_schema.CHECK_CONSTRAINTS", Columns: []string{"TABLE_NAME", "CHECK_CLAUSE"},
+			Rows: [][]driver.Value{
+				{"posts", "(char_length(`slug`) >= 3)"},
+			}},
+	})
+	defer db.Close()
+
+	tables, err := introspectMySQL(db)
+	if err != nil {
+		t.Fatalf("could not introspect mysql: %v", err)
+	}
+	posts := findTable(tables, "posts")
+	if posts == nil || posts.Comment != "blog posts" || len(posts.Columns) != 3 {
+		t.Fatalf("unexpected table posts %+v", posts)
+	}
+	if id := findColumn(posts.Columns, "id"); !id.IsPrimaryKey || !id.HasDefault {
+		t.Errorf("expected id to be an auto increment primary key, got %+v", id)
+	}
+	slug := findColumn(posts.Columns, "slug")
+	if !slug.IsUnique || slug.MaxLength != 140 || slug.Comment != "used in urls" || slug.IsNullable ||
+		len(slug.CheckConstraints) != 1 || slug.CheckConstraints[0] != "CHECK ((char_length(`slug`) >= 3))" {
+		t.Errorf("unexpected column slug %+v", slug)
+	}
+	if status := findColumn(posts.Columns, "status"); !status.IsNullable || status.Default != "draft" {
+		t.Errorf("unexpected column status %+v", status)
+	}
+
+	// the foreign key on multiple columns is not a relationship
+	comments := findTable(tables, "comments")
+	if len(comments.ForeignKeys) != 1 {
+		t.Fatalf("expected 1 foreign key on a single column but got %v", len(comments.ForeignKeys))
+	}
+	if post := comments.ForeignKeys[0]; post.Column != "post" || post.ForeignTable != "posts" ||
+		post.ForeignColumn != "id" {
+		t.Errorf("unexpected foreign key %+v", post)
+	}
+
+	models := tablesToBoilerModels(tables, newInitialisms(nil))
+	if post := findBoilerField(models.BoilerModels[0].Fields, "Post"); post == nil || !post.IsRelation ||
+		post.RelationshipName != "Post" || post.Relationship.Name != "Post" {
+		t.Errorf("expected post to be the foreign key of relationship Post, got %+v", post)
+	}
+	if comments := findBoilerField(models.BoilerModels[1].Fields, "Comments"); comments == nil || !comments.IsArray {
+		t.Errorf("expected relationship Comments on Post, got %+v", comments)
+	}
+}
@@ -0,0 +1,167 @@
+package schema
+
+import (
+	"database/sql"
+	"strings"
+)
+
+func introspectPostgres(db *sql.DB, schema string) ([]*dbTable, error) {
+	tables, err := introspectPostgresTables(db, schema)
+	if err != nil {
+		return nil, err
+	}
+	if err := introspectPostgresColumns(db, schema, tables); err != nil {
+		return nil, err
+	}
+	if err := introspectPostgresConstraints(db, schema, tables); err != nil {
+		return nil, err
+	}
+	if err := introspectPostgresUniqueIndexes(db, schema, tables); err != nil {
+		return nil, err
+	}
+	return tables, nil
+}
+
+func introspectPostgresTables(db *sql.DB, schema string) ([]*dbTable, error) {
+	rows, err := db.Query(`
+		SELECT c.relname, COALESCE(obj_description(c.oid, 'pg_class'), '')
+		FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = $1 AND c.relkind IN ('r', 'p')
+		ORDER BY c.relname`, schema)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tables []*dbTable
+	for rows.Next() {
+		table := &dbTable{}
+		if err := rows.Scan(&table.Name, &table.Comment); err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+	return tables, rows.Err()
+}
+
+func introspectPostgresColumns(db *sql.DB, schema string, tables []*dbTable) error {
+	rows, err := db.Query(`
+		SELECT
+			c.table_name,
+			c.column_name,
+			CASE WHEN c.data_type IN ('ARRAY', 'USER-DEFINED') THEN c.udt_name ELSE c.data_type END,
+			c.is_nullable = 'YES',
+			c.column_default,
+			COALESCE(c.character_maximum_length, 0),
+			COALESCE(col_description(format('%I.%I', c.table_schema, c.table_name)::regclass, c.ordinal_position), '')
+		FROM information_schema.columns c
+		WHERE c.table_schema = $1
+		ORDER BY c.table_name, c.ordinal_position`, schema)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tableName string
+		var defaultValue sql.NullString
+		column := &Column{}
+		if err := rows.Scan(&tableName, &column.Name, &column.DBType, &column.IsNullable, &defaultValue,
+			&column.MaxLength, &column.Comment); err != nil {
+			return err
+		}
+		column.Default = defaultValue.String
+		column.HasDefault = defaultValue.Valid
+		if table := findTable(tables, tableName); table != nil {
+			table.Columns = append(table.Columns, column)
+		}
+	}
+	return rows.Err()
+}
+
+func introspectPostgresConstraints(db *sql.DB, schema string, tables []*dbTable) error {
+	rows, err := db.Query(`
+		SELECT
+			cl.relname,
+			con.contype,
+			array_to_string(ARRAY(
+				SELECT a.attname FROM unnest(con.conkey) k
+				JOIN pg_attribute a ON a.attrelid = con.conrelid AND a.attnum = k
+			), ','),
+			COALESCE(fcl.relname, ''),
+			array_to_string(ARRAY(
+				SELECT a.attname FROM unnest(con.confkey) k
+				JOIN pg_attribute a ON a.attrelid = con.confrelid AND a.attnum = k
+			), ','),
+			pg_get_constraintdef(con.oid)
+		FROM pg_constraint con
+		JOIN pg_class cl ON cl.oid = con.conrelid
+		JOIN pg_namespace n ON n.oid = cl.relnamespace
+		LEFT JOIN pg_class fcl ON fcl.oid = con.confrelid
+		WHERE n.nspname = $1 AND con.contype IN ('p', 'f', 'c')
+		ORDER BY cl.relname, con.conname`, schema)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tableName, constraintType, columns, foreignTable, foreignColumns, definition string
+		if err := rows.Scan(&tableName, &constraintType, &columns, &foreignTable, &foreignColumns,
+			&definition); err != nil {
+			return err
+		}
+		table := findTable(tables, tableName)
+		if table == nil {
+			continue
+		}
+		switch constraintType {
+		case "p":
+			for _, columnName := range strings.Split(columns, ",") {
+				if column := findColumn(table.Columns, columnName); column != nil {
+					column.IsPrimaryKey = true
+				}
+			}
+		case "f":
+			// relationships are only supported for foreign keys on a single column
+			if !strings.Contains(columns, ",") {
+				table.ForeignKeys = append(table.ForeignKeys, &dbForeignKey{
+					Column:        columns,
+					ForeignTable:  foreignTable,
+					ForeignColumn: foreignColumns,
+				})
+			}
+		case "c":
+			addCheckConstraint(table, definition)
+		}
+	}
+	return rows.Err()
+}
+
+func introspectPostgresUniqueIndexes(db *sql.DB, schema string, tables []*dbTable) error {
+	rows, err := db.Query(`
+		SELECT t.relname, a.attname
+		FROM pg_index i
+		JOIN pg_class t ON t.oid = i.indrelid
+		JOIN pg_namespace n ON n.oid = t.relnamespace
+		JOIN pg_attribute a ON a.attrelid = t.oid AND a.attnum = i.indkey[0]
+		WHERE n.nspname = $1 AND i.indisunique AND i.indnatts = 1`, schema)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tableName, columnName string
+		if err := rows.Scan(&tableName, &columnName); err != nil {
+			return err
+		}
+		if table := findTable(tables, tableName); table != nil {
+			if column := findColumn(table.Columns, columnName); column != nil {
+				column.IsUnique = true
+			}
+		}
+	}
+	return rows.Err()
+}
@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"database/sql/driver"
+	"testing"
+)
+
+func TestIntrospectPostgres(t *testing.T) {
+	db := openFakeDatabase(t, []*fakeResult{
+		{Query: "obj_description", Columns: []string{"relname", "comment"}, Rows: [][]driver.Value{
+			{"posts", ""}, {"users", "people who use the app"},
+		}},
+		{Query: "information_schema.columns", Columns: []string{"table_name", "column_name", "data_type",
+			"is_nullable", "column_default", "max_length", "comment"}, Rows: [][]driver.Value{
+			{"posts", "id", "integer", false, "nextval('posts_id_seq'::regclass)", int64(0), ""},
+			{"posts", "author", "integer", false, nil, int64(0), ""},
+			{"posts", "editor_uuid", "uuid", true, nil, int64(0), ""},
+			{"posts", "tags", "_text", true, nil, int64(0), ""},
+			{"users", "id", "uuid", false, "gen_random_uuid()", int64(0), ""},
+			{"users", "email", "character varying", false, nil, int64(255), "where we send mail"},
+		}},
+		{Query: "pg_constraint", Columns: []string{"relname", "contype", "columns", "foreign_table",
+			"foreign_columns", "definition"}, Rows: [][]driver.Value{
+			{"posts", "f", "author", "users", "id", "FOREIGN KEY (author) REFERENCES users(id)"},
+			{"posts", "f", "editor_uuid", "users", "id", "FOREIGN KEY (editor_uuid) REFERENCES users(id)"},
+			{"posts", "f", "id,author", "revisions", "post_id,user_id", "FOREIGN KEY (id, author) REFERENCES ..."},
+			{"posts", "p", "id", "", "", "PRIMARY KEY (id)"},
+			{"users", "c", "email", "", "", "CHECK ((length((email)::text) > 3))"},
+			{"users", "p", "id", "", "", "PRIMARY KEY (id)"},
+		}},
+		{Query: "pg_index", Columns: []string{"relname", "attname"}, Rows: [][]driver.Value{
+			{"users", "email"},
+		}},
+	})
+	defer db.Close()
+
+	tables, err := introspectPostgres(db, "public")
+	if err != nil {
+		t.Fatalf("could not introspect postgres: %v", err)
+	}
+	users := findTable(tables, "users")
+	if users == nil || users.Comment != "people who use the app" || len(users.Columns) != 2 {
+		t.Fatalf("unexpected table users %+v", users)
+	}
+	email := findColumn(users.Columns, "email")
+	if email.DBType != "character varying" || email.MaxLength != 255 || !email.IsUnique || email.IsNullable ||
+		email.Comment != "where we send mail" || len(email.CheckConstraints) != 1 {
+		t.Errorf("unexpected column email %+v", email)
+	}
+	if id := findColumn(users.Columns, "id"); !id.IsPrimaryKey || !id.HasDefault || id.Default != "gen_random_uuid()" {
+		t.Errorf("unexpected column id %+v", id)
+	}
+	if tags := findColumn(findTable(tables, "posts").Columns, "tags"); tags.DBType != "_text" || !tags.IsNullable {
+		t.Errorf("unexpected array column tags %+v", tags)
+	}
+
+	// the foreign key on multiple columns is not a relationship
+	posts := findTable(tables, "posts")
+	if len(posts.ForeignKeys) != 2 {
+		t.Errorf("expected 2 foreign keys on a single column but got %v", len(posts.ForeignKeys))
+	}
+	if author := findForeignKey(tables, "posts", "author"); author == nil || author.ForeignTable != "users" ||
+		author.ForeignColumn != "id" {
+		t.Errorf("unexpected foreign key author %+v", author)
+	}
+
+	// foreign keys are relationships whatever the name of their column is
+	models := tablesToBoilerModels(tables, newInitialisms(nil))
+	post := models.BoilerModels[0]
+	for _, expected := range []struct {
+		field        string
+		relationship string
+	}{{"Author", "Author"}, {"EditorUUID", "Editor"}} {
+		field := findBoilerField(post.Fields, expected.field)
+		if field == nil || !field.IsRelation || !field.IsForeignKey || field.RelationshipName != expected.relationship ||
+			field.Relationship == nil || field.Relationship.Name != "User" {
+			t.Errorf("expected %v to be the foreign key of relationship %v to User, got %+v", expected.field,
+				expected.relationship, field)
+		}
+	}
+	user := models.BoilerModels[1]
+	for _, name := range []string{"AuthorPosts", "EditorPosts"} {
+		if field := findBoilerField(user.Fields, name); field == nil || !field.IsRelation || !field.IsArray {
+			t.Errorf("expected relationship %v on User, got %+v", name, field)
+		}
+	}
+}
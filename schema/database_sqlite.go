@@ -0,0 +1,207 @@
+package schema
+
+import (
+	"database/sql"
+	"strings"
+	"unicode"
+)
+
+// introspectSQLite reads the tables with PRAGMA statements, check constraints are parsed from the CREATE TABLE
+// statement since SQLite has no other way to get them. SQLite does not support comments.
+func introspectSQLite(db *sql.DB) ([]*dbTable, error) {
+	rows, err := db.Query("SELECT name, sql FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%' " +
+		"ORDER BY name")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tables []*dbTable
+	createStatements := map[string]string{}
+	for rows.Next() {
+		var name, createStatement string
+		if err := rows.Scan(&name, &createStatement); err != nil {
+			return nil, err
+		}
+		tables = append(tables, &dbTable{Name: name})
+		createStatements[name] = createStatement
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, table := range tables {
+		if err := introspectSQLiteColumns(db, table); err != nil {
+			return nil, err
+		}
+		if err := introspectSQLiteForeignKeys(db, table); err != nil {
+			return nil, err
+		}
+		if err := introspectSQLiteUniqueIndexes(db, table); err != nil {
+			return nil, err
+		}
+		for _, checkConstraint := range getCheckConstraints(createStatements[table.Name]) {
+			addCheckConstraint(table, checkConstraint)
+		}
+	}
+	return tables, nil
+}
+
+func introspectSQLiteColumns(db *sql.DB, table *dbTable) error {
+	rows, err := db.Query("SELECT name, type, \"notnull\", dflt_value, pk FROM pragma_table_info(?)", table.Name)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var primaryKeys []*Column
+	for rows.Next() {
+		var name, dbType string
+		var notNull bool
+		var defaultValue sql.NullString
+		var primaryKey int
+		if err := rows.Scan(&name, &dbType, &notNull, &defaultValue, &primaryKey); err != nil {
+			return err
+		}
+		column := &Column{
+			Name:         name,
+			DBType:       dbType,
+			Default:      defaultValue.String,
+			HasDefault:   defaultValue.Valid,
+			IsNullable:   !notNull && primaryKey == 0,
+			IsPrimaryKey: primaryKey > 0,
+			MaxLength:    getMaxLength(dbType),
+		}
+		if column.IsPrimaryKey {
+			primaryKeys = append(primaryKeys, column)
+		}
+		table.Columns = append(table.Columns, column)
+	}
+
+	// INTEGER PRIMARY KEY is an alias for the rowid which is filled automatically
+	if len(primaryKeys) == 1 && strings.EqualFold(primaryKeys[0].DBType, "integer") {
+		primaryKeys[0].HasDefault = true
+	}
+	return rows.Err()
+}
+
+func introspectSQLiteForeignKeys(db *sql.DB, table *dbTable) error {
+	// relationships are only supported for foreign keys on a single column
+	rows, err := db.Query("SELECT \"table\", \"from\", \"to\" FROM pragma_foreign_key_list(?) WHERE id IN "+
+		"(SELECT id FROM pragma_foreign_key_list(?) GROUP BY id HAVING count(*) = 1)", table.Name, table.Name)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		foreignKey := &dbForeignKey{}
+		var foreignColumn sql.NullString
+		if err := rows.Scan(&foreignKey.ForeignTable, &foreignKey.Column, &foreignColumn); err != nil {
+			return err
+		}
+		foreignKey.ForeignColumn = foreignColumn.String
+		table.ForeignKeys = append(table.ForeignKeys, foreignKey)
+	}
+	return rows.Err()
+}
+
+func introspectSQLiteUniqueIndexes(db *sql.DB, table *dbTable) error {
+	rows, err := db.Query("SELECT il.name, group_concat(ii.name) FROM pragma_index_list(?) il, "+
+		"pragma_index_info(il.name) ii WHERE il.\"unique\" = 1 GROUP BY il.name HAVING count(*) = 1", table.Name)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var indexName, columnName string
+		if err := rows.Scan(&indexName, &columnName); err != nil {
+			return err
+		}
+		if column := findColumn(table.Columns, columnName); column != nil {
+			column.IsUnique = true
+		}
+	}
+	return rows.Err()
+}
+
+// getCheckConstraints returns every CHECK (...) expression of a CREATE TABLE statement, the keyword has to be a whole
+// word outside of quotes so e.g. a column named checked_at or "check" is no constraint
+func getCheckConstraints(createStatement string) []string {
+	var checkConstraints []string
+	for i := 0; i < len(createStatement); {
+		switch c := createStatement[i]; {
+		case isQuote(c):
+			i = skipQuoted(createStatement, i)
+		case isIdentifierByte(c):
+			start := i
+			for i < len(createStatement) && isIdentifierByte(createStatement[i]) {
+				i++
+			}
+			if !strings.EqualFold(createStatement[start:i], "CHECK") {
+				continue
+			}
+			open := i
+			for open < len(createStatement) && unicode.IsSpace(rune(createStatement[open])) {
+				open++
+			}
+			if open == len(createStatement) || createStatement[open] != '(' {
+				continue
+			}
+			end := getClosingParenthesis(createStatement, open)
+			if end == -1 {
+				return checkConstraints
+			}
+			checkConstraints = append(checkConstraints, "CHECK "+createStatement[open:end+1])
+			i = end + 1
+		default:
+			i++
+		}
+	}
+	return checkConstraints
+}
+
+// getClosingParenthesis returns the index of the parenthesis which closes the one at open or -1 if it is not closed
+func getClosingParenthesis(s string, open int) int {
+	depth := 0
+	for i := open; i < len(s); {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		default:
+			if isQuote(s[i]) {
+				i = skipQuoted(s, i)
+				continue
+			}
+		}
+		i++
+	}
+	return -1
+}
+
+// isQuote returns true for the start of strings and quoted identifiers e.g. 'text', "name", `name` or [name]
+func isQuote(c byte) bool {
+	return c == '\'' || c == '"' || c == '`' || c == '['
+}
+
+// skipQuoted returns the index after the quoted string or identifier which starts at start
+func skipQuoted(s string, start int) int {
+	closing := s[start]
+	if closing == '[' {
+		closing = ']'
+	}
+	if end := strings.IndexByte(s[start+1:], closing); end != -1 {
+		return start + 1 + end + 1
+	}
+	return len(s)
+}
+
+func isIdentifierByte(c byte) bool {
+	return c == '_' || c == '$' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
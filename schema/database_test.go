@@ -0,0 +1,266 @@
+package schema
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestGenerateFromSQLite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sqlboiler-graphql-schema")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	dsn := "file:" + filepath.Join(dir, "test.db")
+	createStatements, err := ioutil.ReadFile(filepath.Join("testdata", "sqlite", "schema.sql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(string(createStatements)); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	config := Config{
+		Database:    &DatabaseConfig{Driver: "sqlite3", DSN: dsn},
+		Mutations:   true,
+		BatchCreate: true,
+		BatchUpdate: true,
+		BatchDelete: true,
+	}
+	document, err := Generate(config)
+	if err != nil {
+		t.Fatalf("could not generate schema: %v", err)
+	}
+
+	user := findModel(document.Models, "User")
+	if user == nil {
+		t.Fatal("expected model User for table users")
+	}
+	email := findField(user.Fields, "email")
+	if email == nil || email.Column == nil {
+		t.Fatal("expected field email with column metadata")
+	}
+	if email.Column.MaxLength != 255 || !email.Column.IsUnique || len(email.Column.CheckConstraints) != 1 {
+		t.Errorf("expected email to be unique with max length 255 and a check constraint, got %+v", email.Column)
+	}
+
+	assertGolden(t, filepath.Join("testdata", "golden", "sqlite", "all.graphql"), document.SDL)
+
+	config.ConstraintDirectives = true
+	document, err = Generate(config)
+	if err != nil {
+		t.Fatalf("could not generate schema with constraint directives: %v", err)
+	}
+	for _, expected := range []string{constraintDirective, "email: String! @constraint(minLength: 4, maxLength: 255)",
+		"age: Int @constraint(min: 0, max: 150)", "rating: Float @constraint(min: 0, max: 5)",
+		"title: String @constraint(maxLength: 140)"} {
+		if !strings.Contains(document.SDL, expected) {
+			t.Errorf("expected schema with constraint directives to contain %q", expected)
+		}
+	}
+	if _, err := gqlparser.LoadSchema(&ast.Source{Name: "schema.graphql", Input: document.SDL}); err != nil {
+		t.Errorf("generated schema with constraint directives is invalid: %v", err)
+	}
+}
+
+func TestGetGoType(t *testing.T) {
+	tests := []struct {
+		dbType     string
+		isNullable bool
+		expected   string
+	}{
+		{dbType: "integer", expected: "int"},
+		{dbType: "int(11) unsigned", expected: "int"},
+		{dbType: "INTEGER", isNullable: true, expected: "null.Int"},
+		{dbType: "smallint", expected: "int"},
+		{dbType: "mediumint(9)", expected: "int"},
+		{dbType: "serial", expected: "int"},
+		{dbType: "bigint", expected: "int64"},
+		{dbType: "int8", isNullable: true, expected: "null.Int64"},
+		{dbType: "tinyint(1)", expected: "bool"},
+		{dbType: "boolean", isNullable: true, expected: "null.Bool"},
+		{dbType: "numeric(10,2)", expected: "types.Decimal"},
+		{dbType: "double precision", expected: "float64"},
+		{dbType: "real", isNullable: true, expected: "null.Float64"},
+		{dbType: "date", expected: "time.Time"},
+		{dbType: "timestamp with time zone", expected: "time.Time"},
+		{dbType: "DATETIME", isNullable: true, expected: "null.Time"},
+		{dbType: "jsonb", expected: "types.JSON"},
+		{dbType: "bytea", isNullable: true, expected: "null.Bytes"},
+		{dbType: "varchar(255)", expected: "string"},
+		{dbType: "point", expected: "string"},
+		{dbType: "interval", isNullable: true, expected: "null.String"},
+		{dbType: "daterange", expected: "string"},
+		{dbType: "tstzrange", expected: "string"},
+		{dbType: "int4range", expected: "string"},
+		{dbType: "_int4", expected: "types.Int64Array"},
+		{dbType: "text[]", expected: "types.StringArray"},
+		{dbType: "_point", expected: "types.StringArray"},
+		{dbType: "_daterange", expected: "types.StringArray"},
+	}
+	for _, test := range tests {
+		if goType := getGoType(&Column{DBType: test.dbType, IsNullable: test.isNullable}); goType != test.expected {
+			t.Errorf("expected %v (nullable: %v) to be %v but got %v", test.dbType, test.isNullable, test.expected,
+				goType)
+		}
+	}
+}
+
+func TestGetCheckConstraints(t *testing.T) {
+	tests := []struct {
+		createStatement string
+		expected        []string
+	}{
+		{"CREATE TABLE t (checked_at DATETIME, name VARCHAR(10))", nil},
+		{`CREATE TABLE t ("check" TEXT, [check] TEXT, name VARCHAR(10) DEFAULT 'check (1)')`, nil},
+		{"CREATE TABLE t (age INTEGER CHECK (age >= 0), name TEXT check(length(name) > 3))",
+			[]string{"CHECK (age >= 0)", "CHECK (length(name) > 3)"}},
+		{"CREATE TABLE t (code TEXT, CONSTRAINT code_format CHECK (code NOT LIKE '%)%'))",
+			[]string{"CHECK (code NOT LIKE '%)%')"}},
+	}
+	for _, test := range tests {
+		checkConstraints := getCheckConstraints(test.createStatement)
+		if strings.Join(checkConstraints, "|") != strings.Join(test.expected, "|") {
+			t.Errorf("expected check constraints %q of %v but got %q", test.expected, test.createStatement,
+				checkConstraints)
+		}
+	}
+}
+
+func findField(fields []*Field, name string) *Field {
+	for _, field := range fields {
+		if field.Name == name {
+			return field
+		}
+	}
+	return nil
+}
+
+// fakeDriver returns canned rows for the queries of the introspection so postgres and mysql can be tested without
+// a server, the results are registered per data source name
+type fakeDriver struct{}
+
+// fakeResult are the rows of the first query which contains Query
+type fakeResult struct {
+	Query   string
+	Columns []string
+	Rows    [][]driver.Value
+}
+
+var fakeResults = map[string][]*fakeResult{} //nolint:gochecknoglobals
+
+func init() { //nolint:gochecknoinits
+	sql.Register("fake", fakeDriver{})
+}
+
+func (fakeDriver) Open(dsn string) (driver.Conn, error) {
+	return &fakeConn{results: fakeResults[dsn]}, nil
+}
+
+type fakeConn struct {
+	results []*fakeResult
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	for _, result := range c.results {
+		if strings.Contains(query, result.Query) {
+			return &fakeStmt{result: result}, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected query %v", query)
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type fakeStmt struct {
+	result *fakeResult
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("exec is not supported")
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &fakeRows{result: s.result}, nil
+}
+
+type fakeRows struct {
+	result *fakeResult
+	index  int
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.result.Columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.index >= len(r.result.Rows) {
+		return io.EOF
+	}
+	copy(dest, r.result.Rows[r.index])
+	r.index++
+	return nil
+}
+
+// openFakeDatabase opens a database which returns the results for the queries
+func openFakeDatabase(t *testing.T, results []*fakeResult) *sql.DB {
+	t.Helper()
+	fakeResults[t.Name()] = results
+	db, err := sql.Open("fake", t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+// findForeignKey returns the foreign key of a column in a table
+func findForeignKey(tables []*dbTable, tableName string, column string) *dbForeignKey {
+	table := findTable(tables, tableName)
+	if table == nil {
+		return nil
+	}
+	for _, foreignKey := range table.ForeignKeys {
+		if foreignKey.Column == column {
+			return foreignKey
+		}
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+const (
+	removedColumnReason   = "column removed on "
+	deprecationDateLayout = "2006-01-02"
+)
+
+var removedColumnRegex = regexp.MustCompile(removedColumnReason + `(\d{4}-\d{2}-\d{2})`) //nolint:gochecknoglobals
+
+// DeprecationConfig keeps fields of columns which disappeared from the database on the output types for a while
+// so clients which are still using them keep working
+type DeprecationConfig struct {
+	PreviousSchema string        // the previous generated schema, Write fills this with the existing schema
+	GracePeriod    time.Duration // how long fields of removed columns are kept before they are dropped
+	Now            time.Time     // defaults to time.Now()
+}
+
+type DeprecatedField struct {
+	Name      string
+	FullType  string // e.g. String! or if array [String!]
+	RemovedOn time.Time
+	// TypeDefinition is the previous definition of the scalar or enum of the field e.g. scalar JSON, it is written
+	// again when the type is not generated anymore. Nil for types which are still generated.
+	TypeDefinition *ast.Definition
+}
+
+// Reason returns the reason used in the @deprecated directive e.g. column removed on 2020-05-19
+func (f *DeprecatedField) Reason() string {
+	return removedColumnReason + f.RemovedOn.Format(deprecationDateLayout)
+}
+
+// fillDeprecatedFields compares the previous generated schema with the new models and adds the fields which are
+// missing in the new models to the model as deprecated field (as long as the grace period has not been passed)
+func fillDeprecatedFields(models []*Model, config *DeprecationConfig, generatedTypes typeNameRegistry) error {
+	if config == nil || config.PreviousSchema == "" {
+		return nil
+	}
+
+	doc, err := parser.ParseSchema(&ast.Source{Name: "previous schema", Input: config.PreviousSchema})
+	if err != nil {
+		return fmt.Errorf("could not parse previous schema: %v", err)
+	}
+
+	now := config.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	for _, model := range models {
+		previousType := doc.Definitions.ForName(model.Name)
+		if previousType == nil || previousType.Kind != ast.Object {
+			continue
+		}
+		for _, previousField := range previousType.Fields {
+			if modelHasOutputField(model, previousField.Name) {
+				continue
+			}
+
+			// fields with arguments are not generated by us so they can not be removed columns
+			if len(previousField.Arguments) > 0 {
+				continue
+			}
+
+			// scalars and enums of the previous schema are kept, other types which are not generated anymore are
+			// the models of removed relationships so we can not keep this field
+			var typeDefinition *ast.Definition
+			if len(generatedTypes[previousField.Type.Name()]) == 0 {
+				typeDefinition = doc.Definitions.ForName(previousField.Type.Name())
+				if typeDefinition == nil || typeDefinition.Kind != ast.Scalar && typeDefinition.Kind != ast.Enum {
+					continue
+				}
+			}
+
+			removedOn := getRemovedOn(previousField, now)
+			if now.Sub(removedOn) > config.GracePeriod {
+				continue
+			}
+
+			model.DeprecatedFields = append(model.DeprecatedFields, &DeprecatedField{
+				Name:           previousField.Name,
+				FullType:       previousField.Type.String(),
+				RemovedOn:      removedOn,
+				TypeDefinition: typeDefinition,
+			})
+		}
+	}
+	return nil
+}
+
+// getRemovedOn returns the date on which the column was removed if it was already deprecated by us before,
+// otherwise it was removed right now
+func getRemovedOn(field *ast.FieldDefinition, now time.Time) time.Time {
+	deprecated := field.Directives.ForName("deprecated")
+	if deprecated == nil {
+		return now
+	}
+	reason := deprecated.Arguments.ForName("reason")
+	if reason == nil || reason.Value == nil {
+		return now
+	}
+	matches := removedColumnRegex.FindStringSubmatch(reason.Value.Raw)
+	if len(matches) != 2 {
+		return now
+	}
+	removedOn, err := time.Parse(deprecationDateLayout, matches[1])
+	if err != nil {
+		return now
+	}
+	return removedOn
+}
+
+// getDeprecatedTypeDefinitions returns the scalars and enums of deprecated fields which are not generated anymore in
+// alphabetical order
+func getDeprecatedTypeDefinitions(models []*Model) []*ast.Definition {
+	definitions := map[string]*ast.Definition{}
+	var names []string
+	for _, model := range models {
+		for _, field := range model.DeprecatedFields {
+			definition := field.TypeDefinition
+			if definition == nil || definitions[definition.Name] != nil {
+				continue
+			}
+			definitions[definition.Name] = definition
+			names = append(names, definition.Name)
+		}
+	}
+	sort.Strings(names)
+
+	result := make([]*ast.Definition, len(names))
+	for i, name := range names {
+		result[i] = definitions[name]
+	}
+	return result
+}
+
+// writeDeprecatedTypes writes the scalars and enums which are only used by deprecated fields e.g.
+// enum UserRole {
+func writeDeprecatedTypes(s *strings.Builder, models []*Model) {
+	for _, definition := range getDeprecatedTypeDefinitions(models) {
+		if definition.Kind == ast.Scalar {
+			s.WriteString("scalar " + definition.Name)
+			s.WriteString(lineBreak)
+			s.WriteString(lineBreak)
+			continue
+		}
+		s.WriteString("enum " + definition.Name + " {")
+		s.WriteString(lineBreak)
+		for _, value := range definition.EnumValues {
+			s.WriteString(indent + value.Name)
+			s.WriteString(lineBreak)
+		}
+		s.WriteString("}")
+		s.WriteString(lineBreak)
+		s.WriteString(lineBreak)
+	}
+}
+
+func modelHasOutputField(model *Model, name string) bool {
+	for _, field := range model.Fields {
+		if field.BoilerField.IsRelation && field.RelationName == name || !field.BoilerField.IsRelation && field.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package schema
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const previousSocialNetworkSchema = `scalar JSON
+
+enum UserRole {
+	ADMIN
+	MEMBER
+}
+
+type Team {
+	id: ID!
+}
+
+type User {
+	id: ID!
+	firstName: String!
+	nickname: String
+	age: Int @deprecated(reason: "column removed on 2020-05-10")
+	bio: String @deprecated(reason: "column removed on 2020-03-01")
+	role: UserRole!
+	settings: JSON
+	team: Team
+	friendsOf(first: Int): [User]
+}
+`
+
+func TestFillDeprecatedFields(t *testing.T) {
+	now := time.Date(2020, 5, 19, 12, 0, 0, 0, time.UTC)
+	config := Config{
+		ModelDirectory: filepath.Join("testdata", "social-network"),
+		Deprecation: &DeprecationConfig{
+			PreviousSchema: previousSocialNetworkSchema,
+			GracePeriod:    30 * 24 * time.Hour,
+			Now:            now,
+		},
+	}
+	document, err := Generate(config)
+	if err != nil {
+		t.Fatalf("could not generate schema: %v", err)
+	}
+
+	user := findModel(document.Models, "User")
+	expected := map[string]time.Time{
+		"nickname": now,
+		"age":      time.Date(2020, 5, 10, 0, 0, 0, 0, time.UTC),
+		"role":     now,
+		"settings": now,
+	}
+	if len(user.DeprecatedFields) != len(expected) {
+		t.Errorf("expected %v deprecated fields but got %v", len(expected), len(user.DeprecatedFields))
+	}
+	for _, field := range user.DeprecatedFields {
+		removedOn, ok := expected[field.Name]
+		if !ok {
+			t.Errorf("expected %v not to be deprecated", field.Name)
+			continue
+		}
+		if !field.RemovedOn.Equal(removedOn) {
+			t.Errorf("expected %v to be removed on %v but got %v", field.Name, removedOn, field.RemovedOn)
+		}
+	}
+
+	assertSchemaContains(t, document.SDL,
+		"nickname: String @deprecated(reason: \"column removed on 2020-05-19\")",
+		"age: Int @deprecated(reason: \"column removed on 2020-05-10\")",
+		"role: UserRole! @deprecated",
+		"settings: JSON @deprecated",
+		"enum UserRole {\n\tADMIN\n\tMEMBER\n}",
+		"scalar JSON\n",
+	)
+	assertValidSchema(t, document.SDL)
+}
+
+func TestGetRemovedOn(t *testing.T) {
+	now := time.Date(2020, 5, 19, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		directives string
+		expected   time.Time
+	}{
+		{"", now},
+		{`@deprecated`, now},
+		{`@deprecated(reason: "use fullName")`, now},
+		{`@deprecated(reason: "column removed on 2020-13-45")`, now},
+		{`@deprecated(reason: "column removed on 2020-01-02")`, time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		schema, err := gqlparser.LoadSchema(&ast.Source{Input: "type Query { name: String " + test.directives + " }"})
+		if err != nil {
+			t.Fatalf("could not load schema: %v", err)
+		}
+		if removedOn := getRemovedOn(schema.Query.Fields.ForName("name"), now); !removedOn.Equal(test.expected) {
+			t.Errorf("expected %q to be removed on %v but got %v", test.directives, test.expected, removedOn)
+		}
+	}
+}
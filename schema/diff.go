@@ -0,0 +1,406 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+type ChangeLevel int
+
+const (
+	ChangeLevelSafe ChangeLevel = iota
+	ChangeLevelDangerous
+	ChangeLevelBreaking
+)
+
+func (l ChangeLevel) String() string {
+	switch l {
+	case ChangeLevelBreaking:
+		return "breaking"
+	case ChangeLevelDangerous:
+		return "dangerous"
+	default:
+		return "safe"
+	}
+}
+
+type SchemaChange struct {
+	Level   ChangeLevel
+	Path    string // e.g. User or User.firstName or Query.users(filter:)
+	Message string
+}
+
+func (c *SchemaChange) String() string {
+	return "[" + c.Level.String() + "] " + c.Path + ": " + c.Message
+}
+
+// HasBreakingChanges returns true if one of the changes would break existing clients
+func HasBreakingChanges(changes []*SchemaChange) bool {
+	for _, change := range changes {
+		if change.Level == ChangeLevelBreaking {
+			return true
+		}
+	}
+	return false
+}
+
+// DiffGenerated compares the generated part of the current schema with the generated schema, types and fields which
+// you added to a merged schema are no changes but the ones which look generated and are not generated anymore (e.g.
+// of a removed column) are removed
+func DiffGenerated(currentSchema, generatedSchema string) ([]*SchemaChange, error) {
+	currentDoc, err := parser.ParseSchema(&ast.Source{Name: "current schema", Input: currentSchema})
+	if err != nil {
+		return nil, fmt.Errorf("could not parse current schema: %v", err)
+	}
+	generatedDoc, err := parser.ParseSchema(&ast.Source{Name: "generated schema", Input: generatedSchema})
+	if err != nil {
+		return nil, fmt.Errorf("could not parse generated schema: %v", err)
+	}
+	return Diff(formatSchemaDocument(getGeneratedPart(currentDoc, generatedDoc)), generatedSchema)
+}
+
+// Diff compares two schemas and classifies every change as breaking, dangerous or safe. Type extensions are compared
+// together with the type they extend. Rules are the same as graphql-js findBreakingChanges / findDangerousChanges
+func Diff(oldSchema, newSchema string) ([]*SchemaChange, error) {
+	oldDoc, err := parser.ParseSchema(&ast.Source{Name: "current schema", Input: oldSchema})
+	if err != nil {
+		return nil, fmt.Errorf("could not parse current schema: %v", err)
+	}
+	newDoc, err := parser.ParseSchema(&ast.Source{Name: "new schema", Input: newSchema})
+	if err != nil {
+		return nil, fmt.Errorf("could not parse new schema: %v", err)
+	}
+
+	var changes []*SchemaChange
+	add := func(level ChangeLevel, path string, message string) {
+		changes = append(changes, &SchemaChange{Level: level, Path: path, Message: message})
+	}
+
+	diffSchemaDefinitions(getSchemaDefinitions(oldDoc), getSchemaDefinitions(newDoc), add)
+	diffDirectiveDefinitions(oldDoc.Directives, newDoc.Directives, add)
+
+	oldTypes := getTypeDefinitions(oldDoc)
+	newTypes := getTypeDefinitions(newDoc)
+	for _, oldType := range oldTypes {
+		newType := newTypes.ForName(oldType.Name)
+		if newType == nil {
+			add(ChangeLevelBreaking, oldType.Name, "type removed")
+			continue
+		}
+		if oldType.Kind != newType.Kind {
+			add(ChangeLevelBreaking, oldType.Name, fmt.Sprintf("type changed from %v to %v", oldType.Kind, newType.Kind))
+			continue
+		}
+
+		switch oldType.Kind {
+		case ast.Object, ast.Interface:
+			diffOutputFields(oldType, newType, add)
+		case ast.InputObject:
+			diffInputFields(oldType, newType, add)
+		case ast.Enum:
+			diffEnumValues(oldType, newType, add)
+		case ast.Union:
+			diffUnionTypes(oldType, newType, add)
+		}
+	}
+
+	for _, newType := range newTypes {
+		if oldTypes.ForName(newType.Name) == nil {
+			add(ChangeLevelSafe, newType.Name, "type added")
+		}
+	}
+
+	return changes, nil
+}
+
+// getTypeDefinitions returns the types of a schema with their extensions merged into them e.g. extend type Query
+// adds its fields to the Query type, an extension of a type which is not defined is returned as type
+func getTypeDefinitions(doc *ast.SchemaDocument) ast.DefinitionList {
+	var definitions ast.DefinitionList
+	for _, definition := range append(append(ast.DefinitionList{}, doc.Definitions...), doc.Extensions...) {
+		existing := definitions.ForName(definition.Name)
+		if existing == nil {
+			merged := *definition
+			merged.Directives = append(ast.DirectiveList{}, definition.Directives...)
+			merged.Interfaces = append([]string{}, definition.Interfaces...)
+			merged.Fields = append(ast.FieldList{}, definition.Fields...)
+			merged.Types = append([]string{}, definition.Types...)
+			merged.EnumValues = append(ast.EnumValueList{}, definition.EnumValues...)
+			definitions = append(definitions, &merged)
+			continue
+		}
+		existing.Directives = append(existing.Directives, definition.Directives...)
+		existing.Interfaces = append(existing.Interfaces, definition.Interfaces...)
+		existing.Fields = append(existing.Fields, definition.Fields...)
+		existing.Types = append(existing.Types, definition.Types...)
+		existing.EnumValues = append(existing.EnumValues, definition.EnumValues...)
+	}
+	return definitions
+}
+
+// getSchemaDefinitions returns the schema definitions together with the schema extensions e.g.
+// extend schema @link(url: "https://specs.apollo.dev/federation/v2.0", import: ["@key"])
+func getSchemaDefinitions(doc *ast.SchemaDocument) ast.SchemaDefinitionList {
+	return append(append(ast.SchemaDefinitionList{}, doc.Schema...), doc.SchemaExtension...)
+}
+
+// diffSchemaDefinitions compares the root operation types and the directives of the schema definitions and extensions
+func diffSchemaDefinitions(oldSchema, newSchema ast.SchemaDefinitionList, add func(ChangeLevel, string, string)) {
+	oldOperationTypes := getOperationTypes(oldSchema)
+	newOperationTypes := getOperationTypes(newSchema)
+	for _, operation := range []ast.Operation{ast.Query, ast.Mutation, ast.Subscription} {
+		oldType, newType := oldOperationTypes[operation], newOperationTypes[operation]
+		switch {
+		case oldType == newType:
+		case newType == "":
+			add(ChangeLevelBreaking, "schema", string(operation)+" type "+oldType+" removed")
+		case oldType == "":
+			add(ChangeLevelSafe, "schema", string(operation)+" type "+newType+" added")
+		default:
+			add(ChangeLevelBreaking, "schema", string(operation)+" type changed from "+oldType+" to "+newType)
+		}
+	}
+
+	oldDirectives := getSchemaDirectives(oldSchema)
+	newDirectives := getSchemaDirectives(newSchema)
+	for _, oldDirective := range oldDirectives {
+		if !sliceContains(newDirectives, oldDirective) {
+			add(ChangeLevelDangerous, "schema", "directive "+oldDirective+" removed")
+		}
+	}
+	for _, newDirective := range newDirectives {
+		if !sliceContains(oldDirectives, newDirective) {
+			add(ChangeLevelDangerous, "schema", "directive "+newDirective+" added")
+		}
+	}
+}
+
+func getOperationTypes(schemaDefinitions ast.SchemaDefinitionList) map[ast.Operation]string {
+	operationTypes := map[ast.Operation]string{}
+	for _, schemaDefinition := range schemaDefinitions {
+		for _, operationType := range schemaDefinition.OperationTypes {
+			operationTypes[operationType.Operation] = operationType.Type
+		}
+	}
+	return operationTypes
+}
+
+// getSchemaDirectives returns the directives of the schema with their arguments e.g. @link(url: "...")
+func getSchemaDirectives(schemaDefinitions ast.SchemaDefinitionList) []string {
+	var directives []string
+	for _, schemaDefinition := range schemaDefinitions {
+		for _, directive := range schemaDefinition.Directives {
+			var arguments []string
+			for _, argument := range directive.Arguments {
+				arguments = append(arguments, argument.Name+": "+valueString(argument.Value))
+			}
+			if len(arguments) == 0 {
+				directives = append(directives, "@"+directive.Name)
+				continue
+			}
+			directives = append(directives, "@"+directive.Name+"("+strings.Join(arguments, ", ")+")")
+		}
+	}
+	return directives
+}
+
+// diffDirectiveDefinitions compares the declared directives, removing a directive or a location of it breaks
+// schemas which use it
+func diffDirectiveDefinitions(oldDirectives, newDirectives ast.DirectiveDefinitionList,
+	add func(ChangeLevel, string, string)) {
+	for _, oldDirective := range oldDirectives {
+		path := "@" + oldDirective.Name
+		newDirective := newDirectives.ForName(oldDirective.Name)
+		if newDirective == nil {
+			add(ChangeLevelBreaking, path, "directive removed")
+			continue
+		}
+		for _, location := range oldDirective.Locations {
+			if !directiveHasLocation(newDirective, location) {
+				add(ChangeLevelBreaking, path, "location "+string(location)+" removed")
+			}
+		}
+		for _, oldArgument := range oldDirective.Arguments {
+			argumentPath := path + "(" + oldArgument.Name + ":)"
+			newArgument := newDirective.Arguments.ForName(oldArgument.Name)
+			if newArgument == nil {
+				add(ChangeLevelBreaking, argumentPath, "argument removed")
+			} else if !isSafeInputTypeChange(oldArgument.Type, newArgument.Type) {
+				add(ChangeLevelBreaking, argumentPath, typeChangedMessage(oldArgument.Type, newArgument.Type))
+			}
+		}
+		for _, newArgument := range newDirective.Arguments {
+			if oldDirective.Arguments.ForName(newArgument.Name) == nil && newArgument.Type.NonNull &&
+				newArgument.DefaultValue == nil {
+				add(ChangeLevelBreaking, path+"("+newArgument.Name+":)", "required argument added")
+			}
+		}
+	}
+	for _, newDirective := range newDirectives {
+		if oldDirectives.ForName(newDirective.Name) == nil {
+			add(ChangeLevelSafe, "@"+newDirective.Name, "directive added")
+		}
+	}
+}
+
+func directiveHasLocation(directive *ast.DirectiveDefinition, location ast.DirectiveLocation) bool {
+	for _, directiveLocation := range directive.Locations {
+		if directiveLocation == location {
+			return true
+		}
+	}
+	return false
+}
+
+func diffOutputFields(oldType, newType *ast.Definition, add func(ChangeLevel, string, string)) {
+	for _, oldField := range oldType.Fields {
+		path := oldType.Name + "." + oldField.Name
+		newField := newType.Fields.ForName(oldField.Name)
+		if newField == nil {
+			add(ChangeLevelBreaking, path, "field removed")
+			continue
+		}
+		if !isSafeOutputTypeChange(oldField.Type, newField.Type) {
+			add(ChangeLevelBreaking, path, typeChangedMessage(oldField.Type, newField.Type))
+		}
+
+		for _, oldArgument := range oldField.Arguments {
+			argumentPath := path + "(" + oldArgument.Name + ":)"
+			newArgument := newField.Arguments.ForName(oldArgument.Name)
+			if newArgument == nil {
+				add(ChangeLevelBreaking, argumentPath, "argument removed")
+				continue
+			}
+			if !isSafeInputTypeChange(oldArgument.Type, newArgument.Type) {
+				add(ChangeLevelBreaking, argumentPath, typeChangedMessage(oldArgument.Type, newArgument.Type))
+			} else if valueString(oldArgument.DefaultValue) != valueString(newArgument.DefaultValue) {
+				add(ChangeLevelDangerous, argumentPath, "default value changed")
+			}
+		}
+		for _, newArgument := range newField.Arguments {
+			if oldField.Arguments.ForName(newArgument.Name) != nil {
+				continue
+			}
+			argumentPath := path + "(" + newArgument.Name + ":)"
+			if newArgument.Type.NonNull && newArgument.DefaultValue == nil {
+				add(ChangeLevelBreaking, argumentPath, "required argument added")
+			} else {
+				add(ChangeLevelSafe, argumentPath, "optional argument added")
+			}
+		}
+	}
+
+	for _, newField := range newType.Fields {
+		if oldType.Fields.ForName(newField.Name) == nil {
+			add(ChangeLevelSafe, newType.Name+"."+newField.Name, "field added")
+		}
+	}
+}
+
+func diffInputFields(oldType, newType *ast.Definition, add func(ChangeLevel, string, string)) {
+	for _, oldField := range oldType.Fields {
+		path := oldType.Name + "." + oldField.Name
+		newField := newType.Fields.ForName(oldField.Name)
+		if newField == nil {
+			add(ChangeLevelBreaking, path, "input field removed")
+			continue
+		}
+		if !isSafeInputTypeChange(oldField.Type, newField.Type) {
+			add(ChangeLevelBreaking, path, typeChangedMessage(oldField.Type, newField.Type))
+		} else if valueString(oldField.DefaultValue) != valueString(newField.DefaultValue) {
+			add(ChangeLevelDangerous, path, "default value changed")
+		}
+	}
+
+	for _, newField := range newType.Fields {
+		if oldType.Fields.ForName(newField.Name) != nil {
+			continue
+		}
+		path := newType.Name + "." + newField.Name
+		if newField.Type.NonNull && newField.DefaultValue == nil {
+			add(ChangeLevelBreaking, path, "required input field added")
+		} else {
+			add(ChangeLevelSafe, path, "optional input field added")
+		}
+	}
+}
+
+func diffEnumValues(oldType, newType *ast.Definition, add func(ChangeLevel, string, string)) {
+	for _, oldValue := range oldType.EnumValues {
+		if newType.EnumValues.ForName(oldValue.Name) == nil {
+			add(ChangeLevelBreaking, oldType.Name+"."+oldValue.Name, "enum value removed")
+		}
+	}
+	for _, newValue := range newType.EnumValues {
+		if oldType.EnumValues.ForName(newValue.Name) == nil {
+			add(ChangeLevelDangerous, newType.Name+"."+newValue.Name, "enum value added")
+		}
+	}
+}
+
+func diffUnionTypes(oldType, newType *ast.Definition, add func(ChangeLevel, string, string)) {
+	for _, oldMember := range oldType.Types {
+		if !sliceContains(newType.Types, oldMember) {
+			add(ChangeLevelBreaking, oldType.Name, oldMember+" removed from union")
+		}
+	}
+	for _, newMember := range newType.Types {
+		if !sliceContains(oldType.Types, newMember) {
+			add(ChangeLevelDangerous, newType.Name, newMember+" added to union")
+		}
+	}
+}
+
+// isSafeOutputTypeChange returns true if clients reading the old type can still read the new type e.g.
+// String -> String! is safe but String! -> String is not
+func isSafeOutputTypeChange(oldType, newType *ast.Type) bool {
+	if oldType.NonNull {
+		return newType.NonNull && isSafeOutputTypeChange(nullableType(oldType), nullableType(newType))
+	}
+	if newType.NonNull {
+		return isSafeOutputTypeChange(oldType, nullableType(newType))
+	}
+	if oldType.Elem != nil {
+		return newType.Elem != nil && isSafeOutputTypeChange(oldType.Elem, newType.Elem)
+	}
+	return newType.Elem == nil && oldType.NamedType == newType.NamedType
+}
+
+// isSafeInputTypeChange returns true if values clients send for the old type are still valid for the new type e.g.
+// String! -> String is safe but String -> String! is not
+func isSafeInputTypeChange(oldType, newType *ast.Type) bool {
+	if oldType.NonNull {
+		return isSafeInputTypeChange(nullableType(oldType), nullableType(newType))
+	}
+	if newType.NonNull {
+		return false
+	}
+	if oldType.Elem != nil {
+		return newType.Elem != nil && isSafeInputTypeChange(oldType.Elem, newType.Elem)
+	}
+	return newType.Elem == nil && oldType.NamedType == newType.NamedType
+}
+
+func typeChangedMessage(oldType, newType *ast.Type) string {
+	if nullableType(oldType).String() == nullableType(newType).String() {
+		if oldType.NonNull {
+			return "nullability loosened from " + oldType.String() + " to " + newType.String()
+		}
+		return "nullability tightened from " + oldType.String() + " to " + newType.String()
+	}
+	return "type changed from " + oldType.String() + " to " + newType.String()
+}
+
+func nullableType(t *ast.Type) *ast.Type {
+	return &ast.Type{NamedType: t.NamedType, Elem: t.Elem, NonNull: false}
+}
+
+func valueString(v *ast.Value) string {
+	if v == nil {
+		return ""
+	}
+	return v.String()
+}
@@ -0,0 +1,147 @@
+package schema
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name      string
+		oldSchema string
+		newSchema string
+		expected  []string
+	}{
+		{
+			name:      "no changes",
+			oldSchema: "type User { id: ID! }",
+			newSchema: "type User { id: ID! }",
+		},
+		{
+			name:      "types",
+			oldSchema: "type User { id: ID! } type Post { id: ID! } enum Role { ADMIN }",
+			newSchema: "type User { id: ID! } type Comment { id: ID! } input Role { name: String }",
+			expected: []string{"[breaking] Post: type removed", "[breaking] Role: type changed from ENUM to INPUT_OBJECT",
+				"[safe] Comment: type added"},
+		},
+		{
+			name:      "output fields",
+			oldSchema: "type User { id: ID! name: String email: String! age: Int friends: [User] nickname: String }",
+			newSchema: "type User { id: ID! name: String! email: String age: Float friends: [User!]! avatar: String }",
+			expected: []string{"[breaking] User.email: nullability loosened from String! to String",
+				"[breaking] User.age: type changed from Int to Float", "[breaking] User.nickname: field removed",
+				"[safe] User.avatar: field added"},
+		},
+		{
+			name: "arguments",
+			oldSchema: "type Query { users(filter: UserFilter, first: Int = 10, after: String!, search: String): " +
+				"[User] }",
+			newSchema: "type Query { users(filter: UserFilter!, first: Int = 20, after: String, orderBy: String, " +
+				"id: ID!): [User] }",
+			expected: []string{"[breaking] Query.users(filter:): nullability tightened from UserFilter to UserFilter!",
+				"[dangerous] Query.users(first:): default value changed", "[breaking] Query.users(search:): argument removed",
+				"[safe] Query.users(orderBy:): optional argument added",
+				"[breaking] Query.users(id:): required argument added"},
+		},
+		{
+			name:      "input fields",
+			oldSchema: "input UserInput { name: String! email: String age: Int = 18 bio: String }",
+			newSchema: "input UserInput { name: String email: String! age: Int = 21 avatar: String password: String! " +
+				"role: String! = \"MEMBER\" }",
+			expected: []string{"[breaking] UserInput.email: nullability tightened from String to String!",
+				"[dangerous] UserInput.age: default value changed", "[breaking] UserInput.bio: input field removed",
+				"[safe] UserInput.avatar: optional input field added",
+				"[breaking] UserInput.password: required input field added",
+				"[safe] UserInput.role: optional input field added"},
+		},
+		{
+			name:      "enums and unions",
+			oldSchema: "enum Role { ADMIN MEMBER } union Result = User | Post",
+			newSchema: "enum Role { ADMIN GUEST } union Result = User | Comment",
+			expected: []string{"[breaking] Role.MEMBER: enum value removed", "[dangerous] Role.GUEST: enum value added",
+				"[breaking] Result: Post removed from union", "[dangerous] Result: Comment added to union"},
+		},
+		{
+			name:      "extensions",
+			oldSchema: "type Query { users: [User] } extend type Query { posts: [Post] } extend type Comment { id: ID! }",
+			newSchema: "type Query { users: [User] posts: [Post] } type Comment { id: ID! body: String }",
+			expected:  []string{"[safe] Comment.body: field added"},
+		},
+		{
+			name: "schema extensions",
+			oldSchema: "schema { query: Query mutation: Mutation } extend schema @link(url: \"federation\", " +
+				"import: [\"@key\"])",
+			newSchema: "schema { query: Query } extend schema @link(url: \"federation\", import: [\"@key\", \"@shareable\"])",
+			expected: []string{"[breaking] schema: mutation type Mutation removed",
+				`[dangerous] schema: directive @link(url: "federation", import: ["@key"]) removed`,
+				`[dangerous] schema: directive @link(url: "federation", import: ["@key","@shareable"]) added`},
+		},
+		{
+			name: "directives",
+			oldSchema: "directive @auth(role: String) on FIELD_DEFINITION | OBJECT directive @cache on OBJECT " +
+				"directive @trace(name: String) on FIELD_DEFINITION",
+			newSchema: "directive @auth(role: String!, scope: String!) on FIELD_DEFINITION directive @trace on " +
+				"FIELD_DEFINITION directive @search on INPUT_FIELD_DEFINITION",
+			expected: []string{"[breaking] @auth: location OBJECT removed",
+				"[breaking] @auth(role:): nullability tightened from String to String!",
+				"[breaking] @auth(scope:): required argument added", "[breaking] @cache: directive removed",
+				"[breaking] @trace(name:): argument removed", "[safe] @search: directive added"},
+		},
+	}
+	for _, test := range tests {
+		changes, err := Diff(test.oldSchema, test.newSchema)
+		if err != nil {
+			t.Fatalf("%v: could not diff schemas: %v", test.name, err)
+		}
+		var actual []string
+		for _, change := range changes {
+			actual = append(actual, change.String())
+		}
+		if len(actual) != len(test.expected) {
+			t.Errorf("%v: expected changes %q but got %q", test.name, test.expected, actual)
+			continue
+		}
+		for i := range actual {
+			if actual[i] != test.expected[i] {
+				t.Errorf("%v: expected change %q but got %q", test.name, test.expected[i], actual[i])
+			}
+		}
+	}
+}
+
+func TestDiffGenerated(t *testing.T) {
+	document := generateSchema(t, Config{ModelDirectory: filepath.Join("testdata", "social-network"), Mutations: true})
+
+	// customization of a merged schema is no change
+	customizedSchema := strings.Replace(document.SDL, "type User {", "type User {\n\tfullName: String!", 1) +
+		"type Stats {\n\tusers: Int!\n}\n\nextend type Query {\n\tstats: Stats!\n}\n"
+	changes, err := DiffGenerated(customizedSchema, document.SDL)
+	if err != nil {
+		t.Fatalf("could not diff schemas: %v", err)
+	}
+	if len(changes) != 0 || HasBreakingChanges(changes) {
+		t.Errorf("expected no changes for a customized schema but got %v", changes)
+	}
+
+	// a removed column is still a breaking change
+	removedColumnSchema := strings.NewReplacer("type User {", "type User {\n\tnickname: String",
+		"input UserWhere {", "input UserWhere {\n\tnickname: StringFilter").Replace(customizedSchema)
+	changes, err = DiffGenerated(removedColumnSchema, document.SDL)
+	if err != nil {
+		t.Fatalf("could not diff schemas: %v", err)
+	}
+	if len(changes) != 2 || changes[0].String() != "[breaking] User.nickname: field removed" ||
+		changes[1].String() != "[breaking] UserWhere.nickname: input field removed" {
+		t.Errorf("expected nickname to be removed but got %v", changes)
+	}
+}
+
+func TestHasBreakingChanges(t *testing.T) {
+	if HasBreakingChanges([]*SchemaChange{{Level: ChangeLevelSafe}, {Level: ChangeLevelDangerous}}) {
+		t.Errorf("expected safe and dangerous changes not to be breaking")
+	}
+	if !HasBreakingChanges([]*SchemaChange{{Level: ChangeLevelSafe}, {Level: ChangeLevelBreaking}}) {
+		t.Errorf("expected breaking changes to be breaking")
+	}
+}
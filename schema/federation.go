@@ -0,0 +1,119 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+)
+
+const federationLink = `extend schema @link(url: "https://specs.apollo.dev/federation/v2.0", import: ["@key", "@shareable"])`
+
+// federationDirectives are the directives which gqlgen adds to a subgraph (without repeatable which gqlparser does not
+// support)
+const federationDirectives = `directive @link(url: String!, import: [String!]) on SCHEMA
+directive @key(fields: String!, resolvable: Boolean = true) on OBJECT | INTERFACE
+directive @shareable on OBJECT | FIELD_DEFINITION
+`
+
+// FederationConfig generates an Apollo Federation v2 subgraph
+type FederationConfig struct {
+	// ExternalModels are owned by other subgraphs e.g. User, only an entity stub with their key is generated so
+	// relationships to them could be resolved by the gateway. The stub has no other fields so it does not need
+	// @external which is why the link only imports @key and @shareable
+	ExternalModels []string
+}
+
+// setExternalModels marks the models which are owned by other subgraphs
+func setExternalModels(models []*Model, federation *FederationConfig) {
+	if federation == nil {
+		return
+	}
+	for _, name := range federation.ExternalModels {
+		model := findModel(models, name)
+		if model == nil {
+			fmt.Printf("[warn] external model %v does not exist\n", name)
+			continue
+		}
+		model.IsExternal = true
+	}
+}
+
+// getLocalModels returns the models which are owned by this (sub)graph
+func getLocalModels(models []*Model) []*Model {
+	var localModels []*Model
+	for _, model := range models {
+		if !model.IsExternal {
+			localModels = append(localModels, model)
+		}
+	}
+	return localModels
+}
+
+// getKeyFields returns the fields of the federation key of a model e.g. id or post { id } tag { id } for a composite
+// primary key of foreign keys, empty if the model has no primary key
+func getKeyFields(model *Model, models []*Model) string {
+	var keyFields []string
+	for _, field := range model.Fields {
+		if !field.IsPrimaryKey {
+			continue
+		}
+		relation := findModel(models, field.RelationType)
+		if field.BoilerField.IsRelation && relation != nil && !model.IsExternal {
+			keyFields = append(keyFields, field.RelationName+" { "+getKeyFields(relation, models)+" }")
+		} else {
+			keyFields = append(keyFields, field.Name)
+		}
+	}
+	return strings.Join(keyFields, " ")
+}
+
+// getKeyDirective returns the key directive of a model e.g. @key(fields: "id") or an empty string if the schema is
+// not a subgraph or if the model has no primary key
+func getKeyDirective(model *Model, models []*Model, config Config) string {
+	if config.Federation == nil {
+		return ""
+	}
+	keyFields := getKeyFields(model, models)
+	if keyFields == "" {
+		return ""
+	}
+	if model.IsExternal {
+		return `@key(fields: "` + keyFields + `", resolvable: false)`
+	}
+	return `@key(fields: "` + keyFields + `")`
+}
+
+// getShareableDirective returns @shareable for helper types which every subgraph generates in the same way
+func getShareableDirective(config Config) string {
+	if config.Federation == nil {
+		return ""
+	}
+	return " @shareable"
+}
+
+// writeEntityStub writes a model which is owned by another subgraph with only its key fields, the other fields are
+// resolved by the owning subgraph e.g.
+// type User @key(fields: "id", resolvable: false) {
+func writeEntityStub(s *strings.Builder, model *Model, models []*Model, config Config) {
+	keyDirective := getKeyDirective(model, models, config)
+	if keyDirective == "" {
+		fmt.Printf("[warn] external model %v has no primary key to reference it by\n", model.Name)
+		keyDirective = `@key(fields: "id", resolvable: false)`
+	}
+	s.WriteString("type " + model.Name + " " + keyDirective + " {")
+	s.WriteString(lineBreak)
+	var hasKeyFields bool
+	for _, field := range model.Fields {
+		if field.IsPrimaryKey {
+			hasKeyFields = true
+			s.WriteString(indent + field.Name + ": " + field.FullType)
+			s.WriteString(lineBreak)
+		}
+	}
+	if !hasKeyFields {
+		s.WriteString(indent + "id: ID!")
+		s.WriteString(lineBreak)
+	}
+	s.WriteString("}")
+	s.WriteString(lineBreak)
+	s.WriteString(lineBreak)
+}
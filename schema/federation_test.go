@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestFederation(t *testing.T) {
+	tests := []struct {
+		fixture    string
+		config     Config
+		expected   []string
+		unexpected []string
+	}{
+		{
+			fixture: "social-network",
+			config: Config{Mutations: true, BatchDelete: true, MutationErrors: "payload",
+				Federation: &FederationConfig{ExternalModels: []string{"User"}}},
+			expected: []string{federationLink, `type Post @key(fields: "id") {`,
+				"type User @key(fields: \"id\", resolvable: false) {\n\tid: ID!\n}", "type UserError @shareable {",
+				"\tuser: User!\n", "userId: ID!"},
+			// the stub of the user only has its key so there are no @external fields
+			unexpected: []string{"UserWhere", "UserFilter", "createUser", "user(id: ID!)", "@external"},
+		},
+		{
+			fixture:  "composite-keys",
+			config:   Config{Federation: &FederationConfig{}},
+			expected: []string{`type PostTag @key(fields: "post { id } tag { id }") {`, `type Tag @key(fields: "id") {`},
+		},
+	}
+	for _, test := range tests {
+		test.config.ModelDirectory = filepath.Join("testdata", test.fixture)
+		document, err := Generate(test.config)
+		if err != nil {
+			t.Fatalf("could not generate schema: %v", err)
+		}
+		for _, expected := range test.expected {
+			if !strings.Contains(document.SDL, expected) {
+				t.Errorf("expected subgraph of %v to contain %q", test.fixture, expected)
+			}
+		}
+		for _, unexpected := range test.unexpected {
+			if strings.Contains(document.SDL, unexpected) {
+				t.Errorf("expected subgraph of %v not to contain %q", test.fixture, unexpected)
+			}
+		}
+		if _, err := gqlparser.LoadSchema(&ast.Source{Name: "federation.graphql", Input: federationDirectives},
+			&ast.Source{Name: "schema.graphql", Input: document.SDL}); err != nil {
+			t.Errorf("generated subgraph of %v is invalid: %v", test.fixture, err)
+		}
+	}
+}
@@ -0,0 +1,278 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// defaultDeprecationReason is the reason of @deprecated without a reason argument
+const defaultDeprecationReason = "No longer supported"
+
+// introspectionResult is the result of the standard introspection query which tools like Apollo and
+// graphql-code-generator use as schema.json
+type introspectionResult struct {
+	Data struct {
+		Schema *introspectionSchema `json:"__schema"`
+	} `json:"data"`
+}
+
+type introspectionSchema struct {
+	QueryType        *introspectionTypeName    `json:"queryType"`
+	MutationType     *introspectionTypeName    `json:"mutationType"`
+	SubscriptionType *introspectionTypeName    `json:"subscriptionType"`
+	Types            []*introspectionType      `json:"types"`
+	Directives       []*introspectionDirective `json:"directives"`
+}
+
+type introspectionTypeName struct {
+	Name string `json:"name"`
+}
+
+type introspectionType struct {
+	Kind          string                     `json:"kind"`
+	Name          string                     `json:"name"`
+	Description   *string                    `json:"description"`
+	Fields        []*introspectionField      `json:"fields"`
+	InputFields   []*introspectionInputValue `json:"inputFields"`
+	Interfaces    []*introspectionTypeRef    `json:"interfaces"`
+	EnumValues    []*introspectionEnumValue  `json:"enumValues"`
+	PossibleTypes []*introspectionTypeRef    `json:"possibleTypes"`
+}
+
+type introspectionField struct {
+	Name              string                     `json:"name"`
+	Description       *string                    `json:"description"`
+	Args              []*introspectionInputValue `json:"args"`
+	Type              *introspectionTypeRef      `json:"type"`
+	IsDeprecated      bool                       `json:"isDeprecated"`
+	DeprecationReason *string                    `json:"deprecationReason"`
+}
+
+type introspectionInputValue struct {
+	Name         string                `json:"name"`
+	Description  *string               `json:"description"`
+	Type         *introspectionTypeRef `json:"type"`
+	DefaultValue *string               `json:"defaultValue"`
+}
+
+type introspectionEnumValue struct {
+	Name              string  `json:"name"`
+	Description       *string `json:"description"`
+	IsDeprecated      bool    `json:"isDeprecated"`
+	DeprecationReason *string `json:"deprecationReason"`
+}
+
+type introspectionDirective struct {
+	Name        string                     `json:"name"`
+	Description *string                    `json:"description"`
+	Locations   []string                   `json:"locations"`
+	Args        []*introspectionInputValue `json:"args"`
+}
+
+// introspectionTypeRef is a reference to a named type or a wrapping LIST / NON_NULL type e.g. [User!]!
+type introspectionTypeRef struct {
+	Kind   string                `json:"kind"`
+	Name   *string               `json:"name"`
+	OfType *introspectionTypeRef `json:"ofType"`
+}
+
+// Introspect returns the JSON result of the standard introspection query of a schema in SDL without running a server,
+// types and directives are sorted by name so the result only changes when the schema does
+func Introspect(sdl string, config Config) (string, error) {
+	sources := []*ast.Source{{Name: "schema.graphql", Input: sdl}}
+	if config.Federation != nil && !strings.Contains(sdl, "directive @key") {
+		// gqlgen adds the federation directives to a subgraph so they are not in the schema
+		sources = append([]*ast.Source{{Name: "federation.graphql", Input: federationDirectives}}, sources...)
+	}
+	schema, gqlErr := gqlparser.LoadSchema(sources...)
+	if gqlErr != nil {
+		return "", fmt.Errorf("could not load schema: %v", gqlErr)
+	}
+
+	result := &introspectionResult{}
+	result.Data.Schema = getIntrospectionSchema(schema)
+	content, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("could not marshal introspection result: %v", err)
+	}
+	return string(content) + lineBreak, nil
+}
+
+func getIntrospectionSchema(schema *ast.Schema) *introspectionSchema {
+	introspection := &introspectionSchema{
+		QueryType:        getIntrospectionTypeName(schema.Query),
+		MutationType:     getIntrospectionTypeName(schema.Mutation),
+		SubscriptionType: getIntrospectionTypeName(schema.Subscription),
+		Types:            []*introspectionType{},
+		Directives:       []*introspectionDirective{},
+	}
+
+	var typeNames []string
+	for name := range schema.Types {
+		typeNames = append(typeNames, name)
+	}
+	sort.Strings(typeNames)
+	for _, name := range typeNames {
+		introspection.Types = append(introspection.Types, getIntrospectionType(schema, schema.Types[name]))
+	}
+
+	var directiveNames []string
+	for name := range schema.Directives {
+		directiveNames = append(directiveNames, name)
+	}
+	sort.Strings(directiveNames)
+	for _, name := range directiveNames {
+		directive := schema.Directives[name]
+		locations := make([]string, len(directive.Locations))
+		for i, location := range directive.Locations {
+			locations[i] = string(location)
+		}
+		introspection.Directives = append(introspection.Directives, &introspectionDirective{
+			Name:        directive.Name,
+			Description: optionalString(directive.Description),
+			Locations:   locations,
+			Args:        getIntrospectionArguments(schema, directive.Arguments),
+		})
+	}
+	return introspection
+}
+
+func getIntrospectionTypeName(definition *ast.Definition) *introspectionTypeName {
+	if definition == nil {
+		return nil
+	}
+	return &introspectionTypeName{Name: definition.Name}
+}
+
+func getIntrospectionType(schema *ast.Schema, definition *ast.Definition) *introspectionType {
+	introspection := &introspectionType{
+		Kind:        string(definition.Kind),
+		Name:        definition.Name,
+		Description: optionalString(definition.Description),
+	}
+
+	switch definition.Kind {
+	case ast.Object, ast.Interface:
+		introspection.Fields = []*introspectionField{}
+		for _, field := range definition.Fields {
+			// __schema and __type are added to the query type by the parser but are not part of the result
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+			isDeprecated, deprecationReason := getDeprecation(field.Directives)
+			introspection.Fields = append(introspection.Fields, &introspectionField{
+				Name:              field.Name,
+				Description:       optionalString(field.Description),
+				Args:              getIntrospectionArguments(schema, field.Arguments),
+				Type:              getIntrospectionTypeRef(schema, field.Type),
+				IsDeprecated:      isDeprecated,
+				DeprecationReason: deprecationReason,
+			})
+		}
+		if definition.Kind == ast.Object {
+			introspection.Interfaces = []*introspectionTypeRef{}
+			for _, name := range definition.Interfaces {
+				introspection.Interfaces = append(introspection.Interfaces, getNamedTypeRef(schema, name))
+			}
+		}
+	case ast.InputObject:
+		introspection.InputFields = []*introspectionInputValue{}
+		for _, field := range definition.Fields {
+			introspection.InputFields = append(introspection.InputFields, &introspectionInputValue{
+				Name:         field.Name,
+				Description:  optionalString(field.Description),
+				Type:         getIntrospectionTypeRef(schema, field.Type),
+				DefaultValue: getDefaultValue(field.DefaultValue),
+			})
+		}
+	case ast.Enum:
+		introspection.EnumValues = []*introspectionEnumValue{}
+		for _, value := range definition.EnumValues {
+			isDeprecated, deprecationReason := getDeprecation(value.Directives)
+			introspection.EnumValues = append(introspection.EnumValues, &introspectionEnumValue{
+				Name:              value.Name,
+				Description:       optionalString(value.Description),
+				IsDeprecated:      isDeprecated,
+				DeprecationReason: deprecationReason,
+			})
+		}
+	}
+
+	if definition.Kind == ast.Interface || definition.Kind == ast.Union {
+		introspection.PossibleTypes = []*introspectionTypeRef{}
+		for _, possibleType := range schema.GetPossibleTypes(definition) {
+			introspection.PossibleTypes = append(introspection.PossibleTypes, getNamedTypeRef(schema, possibleType.Name))
+		}
+	}
+	return introspection
+}
+
+func getIntrospectionArguments(schema *ast.Schema, arguments ast.ArgumentDefinitionList) []*introspectionInputValue {
+	introspection := []*introspectionInputValue{}
+	for _, argument := range arguments {
+		introspection = append(introspection, &introspectionInputValue{
+			Name:         argument.Name,
+			Description:  optionalString(argument.Description),
+			Type:         getIntrospectionTypeRef(schema, argument.Type),
+			DefaultValue: getDefaultValue(argument.DefaultValue),
+		})
+	}
+	return introspection
+}
+
+// getIntrospectionTypeRef returns the reference of a type e.g. [User!]! results in NON_NULL of LIST of NON_NULL of
+// OBJECT User
+func getIntrospectionTypeRef(schema *ast.Schema, t *ast.Type) *introspectionTypeRef {
+	var ref *introspectionTypeRef
+	if t.Elem != nil {
+		ref = &introspectionTypeRef{Kind: "LIST", OfType: getIntrospectionTypeRef(schema, t.Elem)}
+	} else {
+		ref = getNamedTypeRef(schema, t.NamedType)
+	}
+	if t.NonNull {
+		return &introspectionTypeRef{Kind: "NON_NULL", OfType: ref}
+	}
+	return ref
+}
+
+func getNamedTypeRef(schema *ast.Schema, name string) *introspectionTypeRef {
+	ref := &introspectionTypeRef{Name: optionalString(name)}
+	if schema != nil && schema.Types[name] != nil {
+		ref.Kind = string(schema.Types[name].Kind)
+	}
+	return ref
+}
+
+// getDeprecation returns if a field or enum value is deprecated and the reason of the @deprecated directive
+func getDeprecation(directives ast.DirectiveList) (bool, *string) {
+	deprecated := directives.ForName("deprecated")
+	if deprecated == nil {
+		return false, nil
+	}
+	reason := defaultDeprecationReason
+	if argument := deprecated.Arguments.ForName("reason"); argument != nil && argument.Value != nil {
+		reason = argument.Value.Raw
+	}
+	return true, &reason
+}
+
+// getDefaultValue returns the default value as GraphQL literal e.g. "No longer supported" or nil without default
+func getDefaultValue(value *ast.Value) *string {
+	if value == nil {
+		return nil
+	}
+	defaultValue := value.String()
+	return &defaultValue
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
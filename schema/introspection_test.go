@@ -0,0 +1,137 @@
+package schema
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+)
+
+const introspectionSchemaFixture = `"A user of the app"
+type User implements Node {
+	id: ID!
+	name: String @deprecated(reason: "column removed on 2020-05-19")
+	friends(first: Int = 10, orderBy: UserOrder): [User!]!
+}
+
+interface Node {
+	id: ID!
+}
+
+union Result = User
+
+enum UserOrder {
+	NAME
+	CREATED_AT @deprecated
+}
+
+input UserFilter {
+	search: String
+	ids: [ID!]
+}
+
+type Query {
+	node(id: ID!): Node
+	users(filter: UserFilter): [User!]!
+}
+`
+
+func TestIntrospect(t *testing.T) {
+	content, err := Introspect(introspectionSchemaFixture, Config{})
+	if err != nil {
+		t.Fatalf("could not introspect schema: %v", err)
+	}
+	result := &introspectionResult{}
+	if err := json.Unmarshal([]byte(content), result); err != nil {
+		t.Fatalf("could not unmarshal introspection result: %v", err)
+	}
+	schema := result.Data.Schema
+	if schema.QueryType.Name != "Query" || schema.MutationType != nil || schema.SubscriptionType != nil {
+		t.Errorf("expected only a query type but got %+v %+v %+v", schema.QueryType, schema.MutationType,
+			schema.SubscriptionType)
+	}
+
+	types := map[string]*introspectionType{}
+	for _, introspectionType := range schema.Types {
+		types[introspectionType.Name] = introspectionType
+	}
+	for _, name := range []string{"String", "__Schema", "__TypeKind"} {
+		if types[name] == nil {
+			t.Errorf("expected built-in type %v", name)
+		}
+	}
+
+	user := types["User"]
+	if user.Kind != "OBJECT" || user.Description == nil || *user.Description != "A user of the app" {
+		t.Errorf("unexpected user type %+v", user)
+	}
+	if len(user.Interfaces) != 1 || *user.Interfaces[0].Name != "Node" || user.Interfaces[0].Kind != "INTERFACE" {
+		t.Errorf("expected user to implement Node but got %+v", user.Interfaces)
+	}
+	name := user.Fields[1]
+	if !name.IsDeprecated || *name.DeprecationReason != "column removed on 2020-05-19" || name.Type.Kind != "SCALAR" {
+		t.Errorf("unexpected name field %+v", name)
+	}
+
+	// [User!]!
+	friends := user.Fields[2]
+	if friends.Type.Kind != "NON_NULL" || friends.Type.OfType.Kind != "LIST" ||
+		friends.Type.OfType.OfType.Kind != "NON_NULL" || friends.Type.OfType.OfType.OfType.Kind != "OBJECT" ||
+		*friends.Type.OfType.OfType.OfType.Name != "User" {
+		t.Errorf("unexpected type of friends %+v", friends.Type)
+	}
+	if len(friends.Args) != 2 || *friends.Args[0].DefaultValue != "10" || friends.Args[1].Type.Kind != "ENUM" ||
+		friends.Args[1].DefaultValue != nil {
+		t.Errorf("unexpected arguments of friends %+v", friends.Args)
+	}
+
+	order := types["UserOrder"]
+	if len(order.EnumValues) != 2 || !order.EnumValues[1].IsDeprecated ||
+		*order.EnumValues[1].DeprecationReason != defaultDeprecationReason || order.Fields != nil {
+		t.Errorf("unexpected enum %+v", order)
+	}
+	if filter := types["UserFilter"]; len(filter.InputFields) != 2 || filter.Fields != nil || filter.Interfaces != nil {
+		t.Errorf("unexpected input %+v", filter)
+	}
+	for _, name := range []string{"Node", "Result"} {
+		if possibleTypes := types[name].PossibleTypes; len(possibleTypes) != 1 || *possibleTypes[0].Name != "User" {
+			t.Errorf("expected User to be the possible type of %v but got %+v", name, possibleTypes)
+		}
+	}
+	for _, field := range types["Query"].Fields {
+		if field.Name == "__schema" || field.Name == "__type" {
+			t.Errorf("expected %v not to be a field of the query type", field.Name)
+		}
+	}
+
+	var hasDeprecated bool
+	for _, directive := range schema.Directives {
+		if directive.Name == "deprecated" {
+			hasDeprecated = true
+			if *directive.Args[0].DefaultValue != `"No longer supported"` {
+				t.Errorf("unexpected default reason %v", *directive.Args[0].DefaultValue)
+			}
+		}
+	}
+	if !hasDeprecated {
+		t.Errorf("expected the deprecated directive")
+	}
+}
+
+func TestIntrospectGenerated(t *testing.T) {
+	configs := []Config{
+		{Mutations: true, BatchCreate: true, BatchUpdate: true, BatchDelete: true},
+		{Mutations: true, MutationErrors: "union", Federation: &FederationConfig{}},
+	}
+	for _, fixture := range fixtures {
+		for _, config := range configs {
+			config.ModelDirectory = filepath.Join("testdata", fixture)
+			document, err := Generate(config)
+			if err != nil {
+				t.Fatalf("could not generate schema of %v: %v", fixture, err)
+			}
+			if _, err := Introspect(document.SDL, config); err != nil {
+				t.Errorf("could not introspect schema of %v: %v", fixture, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// DefaultInitialisms are the common initialisms of golint, sqlboiler writes these in upper case e.g. OrganizationID
+var DefaultInitialisms = []string{ //nolint:gochecknoglobals
+	"ACL", "API", "ASCII", "CPU", "CSS", "DNS", "EOF", "GUID", "HTML", "HTTP", "HTTPS", "ID", "IP", "JSON", "LHS",
+	"QPS", "RAM", "RHS", "RPC", "SLA", "SMTP", "SQL", "SSH", "TCP", "TLS", "TTL", "UDP", "UI", "UID", "UUID", "URI",
+	"URL", "UTF8", "VM", "XML", "XMPP", "XSRF", "XSS",
+}
+
+// initialisms converts names between snake case, Go names and GraphQL names e.g. api_key -> APIKey -> apiKey
+type initialisms map[string]bool
+
+func newInitialisms(list []string) initialisms {
+	if list == nil {
+		list = DefaultInitialisms
+	}
+	i := make(initialisms, len(list))
+	for _, initialism := range list {
+		i[strings.ToUpper(initialism)] = true
+	}
+	return i
+}
+
+// toLowerCamel returns the GraphQL name of a Go name e.g. APIKey -> apiKey, OrganizationID -> organizationId and
+// UserIDs -> userIds
+func (i initialisms) toLowerCamel(goName string) string {
+	words := i.splitGoName(goName)
+	for n, word := range words {
+		switch {
+		case n == 0:
+			words[n] = strings.ToLower(word)
+		case strings.ToUpper(word) == word || i[strings.ToUpper(strings.TrimSuffix(word, "s"))]:
+			words[n] = strings.ToUpper(word[:1]) + strings.ToLower(word[1:])
+		}
+	}
+	return strings.Join(words, "")
+}
+
+// toGoName returns the name sqlboiler generates for a table or column e.g. api_key -> APIKey
+func (i initialisms) toGoName(snakeName string) string {
+	parts := strings.Split(strings.ToLower(snakeName), "_")
+	for n, part := range parts {
+		if i[strings.ToUpper(part)] {
+			parts[n] = strings.ToUpper(part)
+		} else if part != "" {
+			parts[n] = strings.ToUpper(part[:1]) + part[1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// splitGoName splits a Go name into words e.g. HTTPStatus -> HTTP Status, APIID -> API ID and UserIDs -> User IDs
+func (i initialisms) splitGoName(goName string) []string {
+	runes := []rune(goName)
+	var words []string
+	for start := 0; start < len(runes); {
+		if !unicode.IsUpper(runes[start]) {
+			end := start + 1
+			for end < len(runes) && !unicode.IsUpper(runes[end]) {
+				end++
+			}
+			words = append(words, string(runes[start:end]))
+			start = end
+			continue
+		}
+
+		// a run of upper case letters e.g. HTTPS in HTTPStatus where the last one starts the next word
+		end := start + 1
+		for end < len(runes) && (unicode.IsUpper(runes[end]) || unicode.IsDigit(runes[end])) {
+			end++
+		}
+		if end < len(runes) && unicode.IsLower(runes[end]) {
+			run := string(runes[start:end])
+			// e.g. IDs
+			isPlural := runes[end] == 's' && (end+1 == len(runes) || !unicode.IsLower(runes[end+1]))
+			if pluralWords := i.splitPrefixes(run); isPlural && pluralWords != nil {
+				pluralWords[len(pluralWords)-1] += "s"
+				words = append(words, pluralWords...)
+				start = end + 1
+				continue
+			}
+			end--
+		}
+		if end > start {
+			words = append(words, i.splitInitialisms(string(runes[start:end]))...)
+			start = end
+			continue
+		}
+
+		// e.g. Status
+		end = start + 1
+		for end < len(runes) && !unicode.IsUpper(runes[end]) {
+			end++
+		}
+		words = append(words, string(runes[start:end]))
+		start = end
+	}
+	return words
+}
+
+// splitInitialisms splits an upper case run into words e.g. APIID -> API ID and SKUID -> SKU ID
+func (i initialisms) splitInitialisms(run string) []string {
+	if words := i.splitPrefixes(run); words != nil {
+		return words
+	}
+	// the run contains an unknown initialism, the known ones at the end are split off e.g. SKUID -> SKU ID
+	for n := len(run) - 1; n > 0; n-- {
+		if i[run[n:]] {
+			return append(i.splitInitialisms(run[:n]), run[n:])
+		}
+	}
+	return []string{run}
+}
+
+// splitPrefixes returns nil if the run does not consist of initialisms only
+func (i initialisms) splitPrefixes(run string) []string {
+	var words []string
+	for len(run) > 0 {
+		match := i.longestPrefix(run)
+		if match == "" {
+			return nil
+		}
+		words = append(words, match)
+		run = run[len(match):]
+	}
+	return words
+}
+
+func (i initialisms) longestPrefix(run string) string {
+	var match string
+	for initialism := range i {
+		if len(initialism) > len(match) && strings.HasPrefix(run, initialism) {
+			match = initialism
+		}
+	}
+	return match
+}
+
+// setPluralNames sets the plural name of every model. A plural name which is the same as the singular name (e.g. News)
+// would result in colliding queries news(id: ID!) and news(filter: NewsFilter) so the suffix is added e.g. NewsList
+func setPluralNames(models []*Model, overrides map[string]string, collisionSuffix string) {
+	if collisionSuffix == "" {
+		collisionSuffix = "List"
+	}
+
+	var collisions []string
+	for _, model := range models {
+		pluralName, ok := overrides[model.Name]
+		if !ok {
+			pluralName = pluralizer.Plural(model.Name)
+		}
+		if strings.EqualFold(pluralName, model.Name) {
+			pluralName = model.Name + collisionSuffix
+			collisions = append(collisions, model.Name+" -> "+pluralName)
+		}
+		model.PluralName = pluralName
+	}
+
+	if len(collisions) > 0 {
+		fmt.Println("[warn] the plural name of these models is the same as their singular name so the plural name got " +
+			"a suffix, add a plural override to choose another name: " + strings.Join(collisions, ", "))
+	}
+}
@@ -0,0 +1,67 @@
+package schema
+
+import "testing"
+
+func TestInitialisms(t *testing.T) {
+	defaultInitialisms := newInitialisms(nil)
+	lowerCamelNames := map[string]string{
+		"ID":             "id",
+		"OrganizationID": "organizationId",
+		"APIKey":         "apiKey",
+		"UUID":           "uuid",
+		"HTTPStatus":     "httpStatus",
+		"HTTPSURL":       "httpsUrl",
+		"SKU":            "sku",
+		"ProductSKU":     "productSku",
+		"SKUID":          "skuId",
+		"IPAddress":      "ipAddress",
+		"WebsiteURL":     "websiteUrl",
+		"UserIDs":        "userIds",
+		"APIID":          "apiId",
+		"FirstName":      "firstName",
+		"Address2":       "address2",
+		"UTF8Name":       "utf8Name",
+	}
+	for goName, expected := range lowerCamelNames {
+		if actual := defaultInitialisms.toLowerCamel(goName); actual != expected {
+			t.Errorf("expected %v to become %v but got %v", goName, expected, actual)
+		}
+	}
+
+	goNames := map[string]string{
+		"api_key":         "APIKey",
+		"organization_id": "OrganizationID",
+		"sku":             "Sku",
+		"http_status":     "HTTPStatus",
+	}
+	for snakeName, expected := range goNames {
+		if actual := defaultInitialisms.toGoName(snakeName); actual != expected {
+			t.Errorf("expected %v to become %v but got %v", snakeName, expected, actual)
+		}
+	}
+
+	customInitialisms := newInitialisms([]string{"ID", "SKU"})
+	if actual := customInitialisms.toGoName("product_sku"); actual != "ProductSKU" {
+		t.Errorf("expected product_sku to become ProductSKU but got %v", actual)
+	}
+	if actual := customInitialisms.toLowerCamel("SKUID"); actual != "skuId" {
+		t.Errorf("expected SKUID to become skuId but got %v", actual)
+	}
+}
+
+func TestSetPluralNames(t *testing.T) {
+	models := []*Model{{Name: "User"}, {Name: "News"}, {Name: "Series"}, {Name: "Person"}, {Name: "Equipment"}}
+	setPluralNames(models, map[string]string{"Person": "People", "Series": "SeriesItems"}, "")
+
+	expected := []string{"Users", "NewsList", "SeriesItems", "People", "EquipmentList"}
+	for i, model := range models {
+		if model.PluralName != expected[i] {
+			t.Errorf("expected plural name of %v to be %v but got %v", model.Name, expected[i], model.PluralName)
+		}
+	}
+
+	setPluralNames(models, nil, "Collection")
+	if models[1].PluralName != "NewsCollection" {
+		t.Errorf("expected plural name of News to be NewsCollection but got %v", models[1].PluralName)
+	}
+}
@@ -0,0 +1,1472 @@
+package schema
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	pluralize "github.com/web-ridge/go-pluralize"
+	gqlgen_sqlboiler "github.com/web-ridge/gqlgen-sqlboiler/v2"
+)
+
+var pluralizer *pluralize.Client //nolint:gochecknoglobals
+
+func init() { //nolint:gochecknoinits
+	pluralizer = pluralize.NewClient()
+}
+
+const (
+	indent    = "\t"
+	lineBreak = "\n"
+)
+
+// DefaultAutoTimestampColumns are the columns sqlboiler fills on insert and update
+var DefaultAutoTimestampColumns = []string{"created_at", "updated_at"} //nolint:gochecknoglobals
+
+// Config decides which parts of the schema are generated
+type Config struct {
+	ModelDirectory  string   // directory where the sqlboiler models are
+	Mutations       bool     // generate mutations for models
+	BatchUpdate     bool     // generate batch update for models
+	BatchCreate     bool     // generate batch create for models
+	BatchDelete     bool     // generate batch delete for models
+	SkipInputFields []string // input names which should be skipped e.g. organizationId
+	Directives      []string // directives which should be added after resolvers e.g. isAuthenticated
+	Pagination      string   // generate pagination support for models e.g. offset
+	Initialisms     []string // upper case words in Go names e.g. ID in OrganizationID, defaults to DefaultInitialisms
+
+	// PluralOverrides are the plural names per model name e.g. {"Person": "People"}
+	PluralOverrides map[string]string
+	// PluralCollisionSuffix is added to plural names which are the same as the singular name e.g. NewsList, defaults
+	// to List
+	PluralCollisionSuffix string
+	// TypeNamePrefix and TypeNameSuffix rename models of which a type collides with another type e.g. a table
+	// named query results in the type DbQuery with prefix Db, without them a collision is an error
+	TypeNamePrefix string
+	TypeNameSuffix string
+
+	JSONScalar string // scalar of json columns, defaults to JSON
+	// BinaryScalar is the scalar of binary columns e.g. bytea, defaults to Base64
+	BinaryScalar string
+	// BinaryInputScalar is the scalar of binary columns in inputs e.g. Upload for multipart uploads, defaults to
+	// BinaryScalar
+	BinaryInputScalar string
+	// CustomTypeScalar is the scalar of columns of which the Go type has no GraphQL type e.g. a Point struct, defaults
+	// to a scalar named after the Go type e.g. Point
+	CustomTypeScalar string
+	// OmitBinaryFromLists makes list queries return {Model}ListItem which has no binary fields to avoid huge payloads
+	OmitBinaryFromLists bool
+	// Search configures the search field of the filters per model name e.g. the columns which are searched
+	Search map[string]*ModelSearch
+	// SearchInput uses search: SearchInput with a query and mode instead of search: String
+	SearchInput bool
+	// TenantField is a column which is set from the auth context instead of by clients e.g. organization_id, it is
+	// removed from inputs, where inputs and output types and the operations of the models get the tenant directive
+	TenantField string
+	// TenantDirective is added to the queries and mutations of models with the tenant field, defaults to tenantScoped
+	TenantDirective string
+	// TenantFieldInOutput keeps the tenant field on the output types
+	TenantFieldInOutput bool
+	// Federation generates an Apollo Federation v2 subgraph, nil generates a normal schema
+	Federation *FederationConfig
+	// ConstraintDirectives adds @constraint(maxLength: 255) directives to inputs based on the length and check
+	// constraints of the columns, only available when the schema is generated from a database
+	ConstraintDirectives bool
+	// MutationErrors returns validation failures as data instead of top-level errors, payload adds errors to the
+	// payloads and union makes mutations return e.g. CreateUserResult = UserPayload | ValidationError | NotFoundError
+	MutationErrors string
+	// BatchUpdatePayloadRecords and BatchDeletePayloadRecords add the updated or deleted records to the batch payload
+	// next to the ids and affected rows
+	BatchUpdatePayloadRecords bool
+	BatchDeletePayloadRecords bool
+	// AutoTimestampColumns are filled by sqlboiler so they are not in inputs, defaults to
+	// DefaultAutoTimestampColumns
+	AutoTimestampColumns []string
+	// HardDeleteDirective generates hardDelete mutations for soft deleted models which are only allowed with this
+	// directive e.g. isAdmin, without it they are not generated
+	HardDeleteDirective string
+
+	// Deprecation keeps fields of removed columns as @deprecated, nil drops them right away
+	Deprecation *DeprecationConfig
+
+	// Database introspects a database instead of parsing the models in ModelDirectory
+	Database *DatabaseConfig
+}
+
+// Document is the generated schema together with the models it is based on
+type Document struct {
+	Models    []*Model
+	Constants []*Constant // e.g. enum values, only available when the schema is generated from the models
+	SDL       string
+}
+
+func (d *Document) String() string {
+	return d.SDL
+}
+
+const queryHelperStructs = `
+input IDFilter {
+	equalTo: ID
+	notEqualTo: ID
+	in: [ID!]
+	notIn: [ID!]
+}
+
+input StringFilter {
+	equalTo: String
+	notEqualTo: String
+
+	in: [String!]
+	notIn: [String!]
+
+	startWith: String
+	notStartWith: String
+
+	endWith: String
+	notEndWith: String
+
+	contain: String
+	notContain: String
+
+	startWithStrict: String # Camel sensitive
+	notStartWithStrict: String # Camel sensitive
+
+	endWithStrict: String # Camel sensitive
+	notEndWithStrict: String # Camel sensitive
+
+	containStrict: String # Camel sensitive
+	notContainStrict: String # Camel sensitive
+}
+
+input IntFilter {
+	equalTo: Int
+	notEqualTo: Int
+	lessThan: Int
+	lessThanOrEqualTo: Int
+	moreThan: Int
+	moreThanOrEqualTo: Int
+	in: [Int!]
+	notIn: [Int!]
+}
+
+input FloatFilter {
+	equalTo: Float
+	notEqualTo: Float
+	lessThan: Float
+	lessThanOrEqualTo: Float
+	moreThan: Float
+	moreThanOrEqualTo: Float
+	in: [Float!]
+	notIn: [Float!]
+}
+
+input BooleanFilter {
+	equalTo: Boolean
+	notEqualTo: Boolean
+}
+`
+
+type Model struct {
+	Name             string
+	PluralName       string       // e.g. Users or NewsList if the plural is the same as the singular name
+	Search           *ModelSearch // nil if the search of the model is not configured
+	IsExternal       bool         // owned by another subgraph of which only the key is generated
+	Description      string       // e.g. table comment
+	Fields           []*Field
+	DeprecatedFields []*DeprecatedField
+	// Implements *string
+}
+
+type Field struct {
+	Name             string
+	RelationName     string            // posts
+	RelationType     string            // Page, User, Post
+	Type             string            // String, ID, Integer
+	FullType         string            // e.g String! or if array [String!]
+	RelationFullType string            // [Posts!]
+	FullTypeOptional string            // e.g. String or if array [String]
+	Description      string            // e.g. doc comment of the struct field or column comment
+	GoType           string            // e.g. github.com/volatiletech/null/v8.String, empty for relations
+	Tag              reflect.StructTag // e.g. boil:"email" json:"email"
+	IsPrimaryKey     bool              // e.g. id or both post_id and tag_id of post_tags
+	IsForeignKey     bool              // e.g. organization_id which has a relationship to organizations
+	HasDefault       bool              // the database fills the column if it is not given e.g. auto increment ids
+	IsList           bool              // e.g. text[] columns which are [String!] of which Type is String
+	IsJSON           bool              // e.g. jsonb columns which are the JSON scalar
+	IsBinary         bool              // e.g. bytea columns which are the Base64 scalar
+	IsCustomType     bool              // e.g. a Point struct column which is the Point scalar
+	IsSoftDelete     bool              // deleted_at which sqlboiler uses for soft deletes
+	IsAutoTimestamp  bool              // e.g. created_at and updated_at which sqlboiler fills
+	IsTenant         bool              // the tenant field e.g. organization_id which is set from the auth context
+	BoilerField      *gqlgen_sqlboiler.BoilerField
+	Column           *Column // only available when the schema is generated from a database
+}
+
+// Generate parses the sqlboiler models (or introspects the database) and generates the schema based on the config
+func Generate(config Config) (*Document, error) {
+	if config.MutationErrors != "" && config.MutationErrors != mutationErrorsPayload &&
+		config.MutationErrors != mutationErrorsUnion {
+		return nil, fmt.Errorf("unknown mutation errors %v, expected %v or %v", config.MutationErrors,
+			mutationErrorsPayload, mutationErrorsUnion)
+	}
+
+	models, constants, err := getModels(config)
+	if err != nil {
+		return nil, err
+	}
+	setPluralNames(models, config.PluralOverrides, config.PluralCollisionSuffix)
+	if err := setModelSearch(models, config.Search); err != nil {
+		return nil, err
+	}
+	setExternalModels(models, config.Federation)
+	if err := resolveTypeNameCollisions(models, config); err != nil {
+		return nil, err
+	}
+	if err := fillDeprecatedFields(models, config.Deprecation, getTypeNameRegistry(models, config)); err != nil {
+		return nil, fmt.Errorf("removed columns could not be deprecated: %v", err)
+	}
+
+	return &Document{
+		Models:    models,
+		Constants: constants,
+		SDL:       getSchema(models, config),
+	}, nil
+}
+
+func getModels(config Config) ([]*Model, []*Constant, error) {
+	converter := newModelConverter(config)
+	if config.Database == nil {
+		// Parse models and their fields based on the sqlboiler model directory, the relationships are detected by
+		// gqlgen-sqlboiler and the types and foreign keys of the fields by type checking the models
+		boilerModels := gqlgen_sqlboiler.GetBoilerModels(config.ModelDirectory)
+		goPackage, err := loadGoPackage(config.ModelDirectory)
+		if err != nil {
+			return nil, nil, err
+		}
+		setForeignKeys(boilerModels, goPackage.Models)
+		return converter.boilerModelsToModels(boilerModels, goPackage.Models), goPackage.Constants, nil
+	}
+
+	databaseModels, err := getDatabaseModels(*config.Database, converter.names)
+	if err != nil {
+		return nil, nil, err
+	}
+	models := converter.boilerModelsToModels(databaseModels.BoilerModels, databaseModels.getGoModels())
+	for i, model := range models {
+		model.Description = databaseModels.Comments[databaseModels.BoilerModels[i]]
+		for _, field := range model.Fields {
+			field.Column = databaseModels.Columns[field.BoilerField]
+		}
+	}
+	return models, nil, nil
+}
+
+//nolint:gocognit,gocyclo // TODO: refactor this
+func getSchema(allModels []*Model, config Config) string {
+	var s strings.Builder
+	names := newInitialisms(config.Initialisms)
+	// models of other subgraphs only get an entity stub
+	models := getLocalModels(allModels)
+
+	if config.Federation != nil {
+		s.WriteString(federationLink)
+		s.WriteString(lineBreak)
+		s.WriteString(lineBreak)
+	}
+
+	var fullDirectives []string // nolint:prealloc
+	for _, defaultDirective := range config.Directives {
+		fullDirectives = append(fullDirectives, "@"+defaultDirective)
+		s.WriteString(fmt.Sprintf("directive @%v on FIELD_DEFINITION", defaultDirective))
+		s.WriteString(lineBreak)
+	}
+	if hasHardDeleteMutations(models, config) && !sliceContains(config.Directives, config.HardDeleteDirective) {
+		s.WriteString(fmt.Sprintf("directive @%v on FIELD_DEFINITION", config.HardDeleteDirective))
+		s.WriteString(lineBreak)
+	}
+	if hasTenantFields(models) && !sliceContains(config.Directives, getTenantDirective(config)) {
+		s.WriteString(fmt.Sprintf("directive @%v on FIELD_DEFINITION", getTenantDirective(config)))
+		s.WriteString(lineBreak)
+	}
+	if hasSearchDirectives(models) {
+		s.WriteString("directive @search(columns: [SearchColumn!]!, mode: SearchMode) on INPUT_FIELD_DEFINITION")
+		s.WriteString(lineBreak)
+	}
+	if config.ConstraintDirectives && config.Mutations && hasConstraints(models, config) {
+		s.WriteString(constraintDirective)
+		s.WriteString(lineBreak)
+	}
+	s.WriteString(lineBreak)
+
+	joinedDirectives := strings.Join(fullDirectives, " ")
+	// Create basic structs e.g.
+	// type User {
+	// 	firstName: String!
+	// 	lastName: String
+	// 	isProgrammer: Boolean!
+	// 	organization: Organization!
+	// }
+	for _, model := range allModels {
+		if model.IsExternal {
+			writeEntityStub(&s, model, allModels, config)
+			continue
+		}
+		writeDescription(&s, "", model.Description)
+		writeModelType(&s, model.Name, getKeyDirective(model, allModels, config), model, func(field *Field) bool {
+			return isHiddenTenantField(field, config)
+		})
+
+		// type UserListItem {
+		// 	firstName: String!
+		// }
+		// is the same as User without binary fields e.g. avatar: Base64
+		if hasListItemType(model, config) {
+			writeModelType(&s, model.Name+"ListItem", "", model, func(field *Field) bool {
+				return field.IsBinary || isHiddenTenantField(field, config)
+			})
+		}
+	}
+
+	// Add helpers for filtering lists
+	s.WriteString(queryHelperStructs)
+	s.WriteString(lineBreak)
+
+	// enum SearchMode {
+	// 	CONTAINS
+	// 	PREFIX
+	// 	FULL_TEXT
+	// }
+	writeSearchTypes(&s, models, config)
+
+	// scalar Base64
+	if hasBinaryFields(models) {
+		for _, scalar := range getBinaryScalars(config) {
+			s.WriteString("scalar " + scalar)
+			s.WriteString(lineBreak)
+			s.WriteString(lineBreak)
+		}
+	}
+
+	// scalar JSON
+	//
+	// input JSONFilter {
+	// 	hasKey: String
+	// 	contains: JSON
+	// 	isNull: Boolean
+	// }
+	if hasJSONFields(models) {
+		jsonScalar := getJSONScalar(config)
+		s.WriteString("scalar " + jsonScalar)
+		s.WriteString(lineBreak)
+		s.WriteString(lineBreak)
+		s.WriteString("input " + jsonScalar + "Filter {")
+		s.WriteString(lineBreak)
+		s.WriteString(indent + "hasKey: String")
+		s.WriteString(lineBreak)
+		s.WriteString(indent + "contains: " + jsonScalar)
+		s.WriteString(lineBreak)
+		s.WriteString(indent + "isNull: Boolean")
+		s.WriteString(lineBreak)
+		s.WriteString("}")
+		s.WriteString(lineBreak)
+		s.WriteString(lineBreak)
+	}
+
+	// scalar Point
+	for _, scalar := range getCustomTypeScalars(models, config) {
+		s.WriteString("scalar " + scalar)
+		s.WriteString(lineBreak)
+		s.WriteString(lineBreak)
+	}
+
+	// scalars and enums of removed columns which are still deprecated
+	writeDeprecatedTypes(&s, allModels)
+
+	// input StringArrayFilter {
+	// 	contains: [String!]
+	// 	containedBy: [String!]
+	// 	overlaps: [String!]
+	// 	isEmpty: Boolean
+	// }
+	for _, elementType := range getArrayFilterTypes(models) {
+		s.WriteString("input " + elementType + "ArrayFilter {")
+		s.WriteString(lineBreak)
+		for _, operator := range []string{"contains", "containedBy", "overlaps"} {
+			s.WriteString(indent + operator + ": [" + elementType + "!]")
+			s.WriteString(lineBreak)
+		}
+		s.WriteString(indent + "isEmpty: Boolean")
+		s.WriteString(lineBreak)
+		s.WriteString("}")
+		s.WriteString(lineBreak)
+		s.WriteString(lineBreak)
+	}
+
+	// generate filter structs per model
+	for _, model := range models {
+		// Ignore some specified input fields
+
+		// Generate a type safe grapql filter
+
+		// Generate the base filter
+		// type UserFilter {
+		// 	search: String
+		// 	where: UserWhere
+		// }
+		s.WriteString("input " + model.Name + "Filter {")
+		s.WriteString(lineBreak)
+		if searchField := getSearchField(model, config); searchField != "" {
+			s.WriteString(indent + searchField)
+			s.WriteString(lineBreak)
+		}
+		s.WriteString(indent + "where: " + model.Name + "Where")
+		s.WriteString(lineBreak)
+		s.WriteString("}")
+		s.WriteString(lineBreak)
+		s.WriteString(lineBreak)
+		// Generate a pagination struct
+		if config.Pagination == "offset" {
+			// type UserPagination {
+			// 	limit: Int!
+			// 	page: Int!
+			// }
+			s.WriteString("input " + model.Name + "Pagination {")
+			s.WriteString(lineBreak)
+			s.WriteString(indent + "limit: Int!")
+			s.WriteString(lineBreak)
+			s.WriteString(indent + "page: Int!")
+			s.WriteString(lineBreak)
+			s.WriteString("}")
+			s.WriteString(lineBreak)
+			s.WriteString(lineBreak)
+		}
+		// Generate a where struct
+		// type UserWhere {
+		// 	id: IDFilter
+		// 	title: StringFilter
+		// 	organization: OrganizationWhere
+		// 	or: FlowBlockWhere
+		// 	and: FlowBlockWhere
+		// }
+		s.WriteString("input " + model.Name + "Where {")
+		s.WriteString(lineBreak)
+		for _, field := range model.Fields {
+			// binary data and custom types can not be filtered on and the tenant is filtered by the resolver
+			if field.IsBinary || field.IsCustomType || field.IsTenant {
+				continue
+			}
+			// models of other subgraphs have no where input
+			if relation := findModel(allModels, field.RelationType); relation != nil && relation.IsExternal &&
+				field.BoilerField.IsRelation {
+				continue
+			}
+			if field.BoilerField.IsRelation {
+				// Support filtering in relationships (atleast schema wise)
+				s.WriteString(indent + field.RelationName + ": " + field.RelationType + "Where")
+				s.WriteString(lineBreak)
+			} else if field.IsList {
+				s.WriteString(indent + field.Name + ": " + field.Type + "ArrayFilter")
+				s.WriteString(lineBreak)
+			} else {
+				s.WriteString(indent + field.Name + ": " + field.Type + "Filter")
+				s.WriteString(lineBreak)
+			}
+		}
+		s.WriteString(indent + "or: " + model.Name + "Where")
+		s.WriteString(lineBreak)
+
+		s.WriteString(indent + "and: " + model.Name + "Where")
+		s.WriteString(lineBreak)
+
+		s.WriteString("}")
+		s.WriteString(lineBreak)
+		s.WriteString(lineBreak)
+	}
+
+	s.WriteString("type Query {")
+	s.WriteString(lineBreak)
+	for _, model := range models {
+		modelDirectives := getModelDirectives(joinedDirectives, model, config)
+
+		// single models
+		s.WriteString(indent)
+		s.WriteString(names.toLowerCamel(model.Name) + "(id: ID!)")
+		s.WriteString(": ")
+		s.WriteString(model.Name + "!")
+		s.WriteString(modelDirectives)
+		s.WriteString(lineBreak)
+
+		// lists
+		modelPluralName := model.PluralName
+		s.WriteString(indent)
+		var paginationParameter string
+		if config.Pagination == "offset" {
+			paginationParameter = ", pagination: " + model.Name + "Pagination"
+		}
+		// soft deleted records are only returned if asked for
+		var withDeletedParameter string
+		if hasSoftDelete(model) {
+			withDeletedParameter = ", withDeleted: Boolean"
+		}
+		s.WriteString(names.toLowerCamel(modelPluralName) + "(filter: " + model.Name + "Filter" +
+			paginationParameter + withDeletedParameter + ")")
+		s.WriteString(": ")
+		if hasListItemType(model, config) {
+			s.WriteString("[" + model.Name + "ListItem!]!")
+		} else {
+			s.WriteString("[" + model.Name + "!]!")
+		}
+		s.WriteString(modelDirectives)
+		s.WriteString(lineBreak)
+	}
+	s.WriteString("}")
+	s.WriteString(lineBreak)
+	s.WriteString(lineBreak)
+
+	// Generate input and payloads for mutatations
+	if config.Mutations { //nolint:nestif
+		writeMutationErrorTypes(&s, config)
+
+		for _, model := range models {
+			modelPluralName := model.PluralName
+			// input UserCreateInput {
+			// 	firstName: String!
+			// 	lastName: String
+			//	organizationId: ID!
+			// }
+			// an input without fields is invalid e.g. a model with only generated columns
+			createInputFields := getCreateInputFields(model, config)
+			if len(createInputFields) > 0 {
+				s.WriteString("input " + model.Name + "CreateInput {")
+				s.WriteString(lineBreak)
+				for _, field := range createInputFields {
+					// the database fills columns with a default value if they are not given
+					if field.HasDefault {
+						s.WriteString(indent + field.Name + ": " + getInputType(field, field.FullTypeOptional, config))
+					} else {
+						s.WriteString(indent + field.Name + ": " + getInputType(field, field.FullType, config))
+					}
+					s.WriteString(getConstraintDirective(field, config))
+					s.WriteString(lineBreak)
+				}
+				s.WriteString("}")
+				s.WriteString(lineBreak)
+				s.WriteString(lineBreak)
+			}
+
+			// input UserUpdateInput {
+			// 	firstName: String!
+			// 	lastName: String
+			//	organizationId: ID!
+			// }
+			updateInputFields := getUpdateInputFields(model, config)
+			if len(updateInputFields) > 0 {
+				s.WriteString("input " + model.Name + "UpdateInput {")
+				s.WriteString(lineBreak)
+				for _, field := range updateInputFields {
+					s.WriteString(indent + field.Name + ": " + getInputType(field, field.FullTypeOptional, config))
+					s.WriteString(getConstraintDirective(field, config))
+					s.WriteString(lineBreak)
+				}
+				s.WriteString("}")
+				s.WriteString(lineBreak)
+				s.WriteString(lineBreak)
+			}
+
+			if config.BatchCreate && len(createInputFields) > 0 {
+				s.WriteString("input " + modelPluralName + "CreateInput {")
+				s.WriteString(lineBreak)
+				s.WriteString(indent + names.toLowerCamel(modelPluralName) + ": [" + model.Name + "CreateInput!]!")
+				s.WriteString("}")
+				s.WriteString(lineBreak)
+				s.WriteString(lineBreak)
+			}
+
+			// input UserBatchUpdateItem {
+			// 	id: ID!
+			// 	input: UserUpdateInput!
+			// }
+			if config.BatchUpdate && len(updateInputFields) > 0 {
+				s.WriteString("input " + model.Name + "BatchUpdateItem {")
+				s.WriteString(lineBreak)
+				s.WriteString(indent + "id: ID!")
+				s.WriteString(lineBreak)
+				s.WriteString(indent + "input: " + model.Name + "UpdateInput!")
+				s.WriteString(lineBreak)
+				s.WriteString("}")
+				s.WriteString(lineBreak)
+				s.WriteString(lineBreak)
+			}
+
+			// type UserPayload {
+			// 	user: User!
+			// }
+			s.WriteString("type " + model.Name + "Payload {")
+			s.WriteString(lineBreak)
+			if config.MutationErrors == mutationErrorsPayload {
+				// the user is not there if the mutation failed
+				s.WriteString(indent + names.toLowerCamel(model.Name) + ": " + model.Name)
+				s.WriteString(lineBreak)
+				s.WriteString(indent + "errors: [UserError!]")
+			} else {
+				s.WriteString(indent + names.toLowerCamel(model.Name) + ": " + model.Name + "!")
+			}
+			s.WriteString(lineBreak)
+			s.WriteString("}")
+			s.WriteString(lineBreak)
+			s.WriteString(lineBreak)
+
+			// TODO batch, delete input and payloads
+
+			// type UserDeletePayload {
+			// 	id: ID!
+			// }
+			s.WriteString("type " + model.Name + "DeletePayload {")
+			s.WriteString(lineBreak)
+			s.WriteString(indent + "id: ID!")
+			s.WriteString(lineBreak)
+			if config.MutationErrors == mutationErrorsPayload {
+				s.WriteString(indent + "errors: [UserError!]")
+				s.WriteString(lineBreak)
+			}
+			s.WriteString("}")
+			s.WriteString(lineBreak)
+			s.WriteString(lineBreak)
+
+			// union CreateUserResult = UserPayload | ValidationError | NotFoundError
+			if config.MutationErrors == mutationErrorsUnion {
+				for _, result := range getMutationResults(model, len(createInputFields) > 0, len(updateInputFields) > 0,
+					config) {
+					s.WriteString("union " + result.Name + " = " + strings.Join(result.Types, " | "))
+					s.WriteString(lineBreak)
+					s.WriteString(lineBreak)
+				}
+			}
+
+			// type UsersPayload {
+			// 	ids: [ID!]!
+			// }
+			if config.BatchCreate && len(createInputFields) > 0 {
+				s.WriteString("type " + modelPluralName + "Payload {")
+				s.WriteString(lineBreak)
+				s.WriteString(indent + names.toLowerCamel(modelPluralName) + ": [" + model.Name + "!]!")
+				s.WriteString(lineBreak)
+				s.WriteString("}")
+				s.WriteString(lineBreak)
+				s.WriteString(lineBreak)
+			}
+
+			// type UsersDeletePayload {
+			// 	ids: [ID!]!
+			// 	affectedRows: Int!
+			// 	users: [User!]!
+			// }
+			if config.BatchDelete {
+				s.WriteString("type " + modelPluralName + "DeletePayload {")
+				s.WriteString(lineBreak)
+				writeBatchPayloadFields(&s, model, config.BatchDeletePayloadRecords, names)
+				s.WriteString("}")
+				s.WriteString(lineBreak)
+				s.WriteString(lineBreak)
+			}
+			// type UsersRestorePayload {
+			// 	ids: [ID!]!
+			// 	affectedRows: Int!
+			// }
+			if config.BatchDelete && hasSoftDelete(model) {
+				s.WriteString("type " + modelPluralName + "RestorePayload {")
+				s.WriteString(lineBreak)
+				writeBatchPayloadFields(&s, model, false, names)
+				s.WriteString("}")
+				s.WriteString(lineBreak)
+				s.WriteString(lineBreak)
+			}
+			// type UsersUpdatePayload {
+			// 	ok: Boolean!
+			// 	ids: [ID!]!
+			// 	affectedRows: Int!
+			// 	users: [User!]!
+			// }
+			if config.BatchUpdate && len(updateInputFields) > 0 {
+				s.WriteString("type " + modelPluralName + "UpdatePayload {")
+				s.WriteString(lineBreak)
+				s.WriteString(indent + "ok: Boolean!")
+				s.WriteString(lineBreak)
+				writeBatchPayloadFields(&s, model, config.BatchUpdatePayloadRecords, names)
+				s.WriteString("}")
+				s.WriteString(lineBreak)
+				s.WriteString(lineBreak)
+
+				// type UsersBatchUpdatePayload {
+				// 	users: [User!]!
+				// }
+				s.WriteString("type " + modelPluralName + "BatchUpdatePayload {")
+				s.WriteString(lineBreak)
+				s.WriteString(indent + names.toLowerCamel(modelPluralName) + ": [" + model.Name + "!]!")
+				s.WriteString(lineBreak)
+				s.WriteString("}")
+				s.WriteString(lineBreak)
+				s.WriteString(lineBreak)
+			}
+		}
+
+		// Generate mutation queries
+		s.WriteString("type Mutation {")
+		s.WriteString(lineBreak)
+		for _, model := range models {
+			modelDirectives := getModelDirectives(joinedDirectives, model, config)
+			modelPluralName := model.PluralName
+			hasCreateInput := len(getCreateInputFields(model, config)) > 0
+			hasUpdateInput := len(getUpdateInputFields(model, config)) > 0
+
+			// create single, models of which all columns are filled by the database or sqlboiler have nothing to
+			// create e.g. a friendship with only auto timestamp columns
+			// e.g createUser(input: UserInput!): UserPayload!
+			if hasCreateInput {
+				s.WriteString(indent)
+				s.WriteString("create" + model.Name + "(input: " + model.Name + "CreateInput!)")
+				s.WriteString(": ")
+				s.WriteString(getMutationResultType("Create", model.Name+"Payload!", model, config))
+				s.WriteString(modelDirectives)
+				s.WriteString(lineBreak)
+			}
+
+			// create multiple
+			// e.g createUsers(input: [UsersInput!]!): UsersPayload!
+			if config.BatchCreate && hasCreateInput {
+				s.WriteString(indent)
+				s.WriteString("create" + modelPluralName + "(input: " + modelPluralName + "CreateInput!)")
+				s.WriteString(": ")
+				s.WriteString(modelPluralName + "Payload!")
+				s.WriteString(modelDirectives)
+				s.WriteString(lineBreak)
+			}
+
+			// update single
+			// e.g updateUser(id: ID!, input: UserInput!): UserPayload!
+			if hasUpdateInput {
+				s.WriteString(indent)
+				s.WriteString("update" + model.Name + "(id: ID!, input: " + model.Name + "UpdateInput!)")
+				s.WriteString(": ")
+				s.WriteString(getMutationResultType("Update", model.Name+"Payload!", model, config))
+				s.WriteString(modelDirectives)
+				s.WriteString(lineBreak)
+			}
+
+			// update multiple (batch update)
+			// e.g updateUsers(filter: UserFilter, input: UsersInput!): UsersPayload!
+			if config.BatchUpdate && hasUpdateInput {
+				s.WriteString(indent)
+				s.WriteString("update" + modelPluralName + "(filter: " + model.Name + "Filter, input: " +
+					model.Name + "UpdateInput!)")
+				s.WriteString(": ")
+				s.WriteString(modelPluralName + "UpdatePayload!")
+				s.WriteString(modelDirectives)
+				s.WriteString(lineBreak)
+
+				// update multiple with different changes per record
+				// e.g updateUsersByIds(input: [UserBatchUpdateItem!]!): UsersBatchUpdatePayload!
+				s.WriteString(indent)
+				s.WriteString("update" + modelPluralName + "ByIds(input: [" + model.Name + "BatchUpdateItem!]!)")
+				s.WriteString(": ")
+				s.WriteString(modelPluralName + "BatchUpdatePayload!")
+				s.WriteString(modelDirectives)
+				s.WriteString(lineBreak)
+			}
+
+			// delete single
+			// e.g deleteUser(id: ID!): UserPayload!
+			s.WriteString(indent)
+			s.WriteString("delete" + model.Name + "(id: ID!)")
+			s.WriteString(": ")
+			s.WriteString(getMutationResultType("Delete", model.Name+"DeletePayload!", model, config))
+			s.WriteString(modelDirectives)
+			s.WriteString(lineBreak)
+
+			// delete multiple
+			// e.g deleteUsers(filter: UserFilter, input: [UsersInput!]!): UsersPayload!
+			if config.BatchDelete {
+				s.WriteString(indent)
+				s.WriteString("delete" + modelPluralName + "(filter: " + model.Name + "Filter)")
+				s.WriteString(": ")
+				s.WriteString(modelPluralName + "DeletePayload!")
+				s.WriteString(modelDirectives)
+				s.WriteString(lineBreak)
+			}
+
+			if hasSoftDelete(model) {
+				writeSoftDeleteMutations(&s, model, config, modelDirectives)
+			}
+		}
+		s.WriteString("}")
+		s.WriteString(lineBreak)
+		s.WriteString(lineBreak)
+	}
+
+	return s.String()
+}
+
+const (
+	mutationErrorsPayload = "payload"
+	mutationErrorsUnion   = "union"
+)
+
+// mutationErrorTypes are the types which are generated for MutationErrors
+var mutationErrorTypes = map[string][]string{ //nolint:gochecknoglobals
+	mutationErrorsPayload: {"UserError"},
+	mutationErrorsUnion:   {"ValidationError", "NotFoundError"},
+}
+
+// writeMutationErrorTypes writes the errors which mutations return as data e.g. UserError or ValidationError and
+// NotFoundError
+func writeMutationErrorTypes(s *strings.Builder, config Config) {
+	switch config.MutationErrors {
+	case mutationErrorsPayload:
+		s.WriteString("type UserError" + getShareableDirective(config) + " {")
+		s.WriteString(lineBreak)
+		s.WriteString(indent + "field: String")
+		s.WriteString(lineBreak)
+		s.WriteString(indent + "message: String!")
+		s.WriteString(lineBreak)
+		s.WriteString(indent + "code: String!")
+		s.WriteString(lineBreak)
+		s.WriteString("}")
+		s.WriteString(lineBreak)
+		s.WriteString(lineBreak)
+	case mutationErrorsUnion:
+		s.WriteString("type ValidationError" + getShareableDirective(config) + " {")
+		s.WriteString(lineBreak)
+		s.WriteString(indent + "field: String")
+		s.WriteString(lineBreak)
+		s.WriteString(indent + "message: String!")
+		s.WriteString(lineBreak)
+		s.WriteString(indent + "code: String!")
+		s.WriteString(lineBreak)
+		s.WriteString("}")
+		s.WriteString(lineBreak)
+		s.WriteString(lineBreak)
+		s.WriteString("type NotFoundError" + getShareableDirective(config) + " {")
+		s.WriteString(lineBreak)
+		s.WriteString(indent + "message: String!")
+		s.WriteString(lineBreak)
+		s.WriteString("}")
+		s.WriteString(lineBreak)
+		s.WriteString(lineBreak)
+	}
+}
+
+// mutationResult is a union of the payload and the errors of a mutation e.g.
+// union CreateUserResult = UserPayload | ValidationError | NotFoundError
+type mutationResult struct {
+	Name  string
+	Types []string
+}
+
+// getMutationResults returns the result unions of the create, update and delete mutation of a model and of the
+// restore and hard delete mutation of a soft deleted model
+func getMutationResults(model *Model, hasCreateInput bool, hasUpdateInput bool, config Config) []*mutationResult {
+	var results []*mutationResult
+	if hasCreateInput {
+		// e.g. the organization of a new user does not exist
+		results = append(results, &mutationResult{
+			Name:  "Create" + model.Name + "Result",
+			Types: []string{model.Name + "Payload", "ValidationError", "NotFoundError"},
+		})
+	}
+	if hasUpdateInput {
+		results = append(results, &mutationResult{
+			Name:  "Update" + model.Name + "Result",
+			Types: []string{model.Name + "Payload", "ValidationError", "NotFoundError"},
+		})
+	}
+	results = append(results, &mutationResult{
+		Name:  "Delete" + model.Name + "Result",
+		Types: []string{model.Name + "DeletePayload", "NotFoundError"},
+	})
+	if !hasSoftDelete(model) {
+		return results
+	}
+	results = append(results, &mutationResult{
+		Name:  "Restore" + model.Name + "Result",
+		Types: []string{model.Name + "Payload", "NotFoundError"},
+	})
+	if config.HardDeleteDirective != "" {
+		results = append(results, &mutationResult{
+			Name:  "HardDelete" + model.Name + "Result",
+			Types: []string{model.Name + "DeletePayload", "NotFoundError"},
+		})
+	}
+	return results
+}
+
+// getMutationResultType returns the result union of a mutation e.g. CreateUserResult! or else the payload
+func getMutationResultType(operation string, payload string, model *Model, config Config) string {
+	if config.MutationErrors != mutationErrorsUnion {
+		return payload
+	}
+	return operation + model.Name + "Result!"
+}
+
+// writeBatchPayloadFields writes the fields of a batch payload which clients need to refresh their cache
+func writeBatchPayloadFields(s *strings.Builder, model *Model, withRecords bool, names initialisms) {
+	s.WriteString(indent + "ids: [ID!]!")
+	s.WriteString(lineBreak)
+	s.WriteString(indent + "affectedRows: Int!")
+	s.WriteString(lineBreak)
+	if withRecords {
+		s.WriteString(indent + names.toLowerCamel(model.PluralName) + ": [" + model.Name + "!]!")
+		s.WriteString(lineBreak)
+	}
+}
+
+// getCreateInputFields returns the fields of {Model}CreateInput
+func getCreateInputFields(model *Model, config Config) []*Field {
+	var fields []*Field
+	for _, field := range fieldsWithout(model.Fields, config.SkipInputFields) {
+		// generated primary keys are not given in create e.g. auto increment ids, natural keys
+		// (e.g. code or the foreign keys of a many to many table) are
+		if field.IsPrimaryKey && field.HasDefault {
+			continue
+		}
+		// sqlboiler fills the timestamps, records are soft deleted by the delete mutation and the tenant is set from
+		// the auth context
+		if field.IsAutoTimestamp || field.IsSoftDelete || field.IsTenant {
+			continue
+		}
+		// not possible yet in input
+		// TODO: make this possible for one-to-one structs?
+		// only for foreign keys inside model itself
+		if field.BoilerField.IsRelation && !field.IsForeignKey {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// getUpdateInputFields returns the fields of {Model}UpdateInput
+func getUpdateInputFields(model *Model, config Config) []*Field {
+	var primaryKeyCount int
+	for _, field := range model.Fields {
+		if field.IsPrimaryKey {
+			primaryKeyCount++
+		}
+	}
+	hasCompositePrimaryKey := primaryKeyCount > 1
+
+	var fields []*Field
+	for _, field := range fieldsWithout(model.Fields, config.SkipInputFields) {
+		// the primary key is specified in the update resolver, composite primary keys can not be given as id
+		if field.IsPrimaryKey && !hasCompositePrimaryKey {
+			continue
+		}
+		if field.IsAutoTimestamp || field.IsSoftDelete || field.IsTenant {
+			continue
+		}
+		if field.BoilerField.IsRelation && !field.IsForeignKey {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// writeSoftDeleteMutations writes the restore mutations of a soft deleted model and the hard delete mutations if they
+// are enabled by the hard delete directive
+func writeSoftDeleteMutations(s *strings.Builder, model *Model, config Config, joinedDirectives string) {
+	modelPluralName := model.PluralName
+
+	// restore single
+	// e.g restoreUser(id: ID!): UserPayload!
+	s.WriteString(indent)
+	s.WriteString("restore" + model.Name + "(id: ID!)")
+	s.WriteString(": ")
+	s.WriteString(getMutationResultType("Restore", model.Name+"Payload!", model, config))
+	s.WriteString(joinedDirectives)
+	s.WriteString(lineBreak)
+
+	// restore multiple
+	// e.g restoreUsers(filter: UserFilter): UsersRestorePayload!
+	if config.BatchDelete {
+		s.WriteString(indent)
+		s.WriteString("restore" + modelPluralName + "(filter: " + model.Name + "Filter)")
+		s.WriteString(": ")
+		s.WriteString(modelPluralName + "RestorePayload!")
+		s.WriteString(joinedDirectives)
+		s.WriteString(lineBreak)
+	}
+
+	if config.HardDeleteDirective == "" {
+		return
+	}
+	hardDeleteDirectives := strings.TrimSpace(joinedDirectives + " @" + config.HardDeleteDirective)
+
+	// hard delete single
+	// e.g hardDeleteUser(id: ID!): UserDeletePayload! @isAdmin
+	s.WriteString(indent)
+	s.WriteString("hardDelete" + model.Name + "(id: ID!)")
+	s.WriteString(": ")
+	s.WriteString(getMutationResultType("HardDelete", model.Name+"DeletePayload!", model, config) + " ")
+	s.WriteString(hardDeleteDirectives)
+	s.WriteString(lineBreak)
+
+	// hard delete multiple
+	// e.g hardDeleteUsers(filter: UserFilter): UsersDeletePayload! @isAdmin
+	if config.BatchDelete {
+		s.WriteString(indent)
+		s.WriteString("hardDelete" + modelPluralName + "(filter: " + model.Name + "Filter)")
+		s.WriteString(": ")
+		s.WriteString(modelPluralName + "DeletePayload! ")
+		s.WriteString(hardDeleteDirectives)
+		s.WriteString(lineBreak)
+	}
+}
+
+// writeModelType writes the type of a model without the skipped fields e.g. binary fields for list items
+func writeModelType(s *strings.Builder, name string, directives string, model *Model, skip func(field *Field) bool) {
+	if directives != "" {
+		name += " " + directives
+	}
+	s.WriteString("type " + name + " {")
+	s.WriteString(lineBreak)
+	for _, field := range model.Fields {
+		if skip(field) {
+			continue
+		}
+		writeDescription(s, indent, field.Description)
+		// e.g we have foreign key from user to organization
+		// organizationID is clutter in your scheme
+		// you only want Organization and OrganizationID should be skipped
+		if field.BoilerField.IsRelation {
+			s.WriteString(indent + field.RelationName + ": " + field.RelationFullType)
+			s.WriteString(lineBreak)
+		} else {
+			s.WriteString(indent + field.Name + ": " + field.FullType)
+			s.WriteString(lineBreak)
+		}
+	}
+	// columns which are removed but clients could still depend on
+	for _, field := range model.DeprecatedFields {
+		s.WriteString(indent + field.Name + ": " + field.FullType + " @deprecated(reason: \"" + field.Reason() + "\")")
+		s.WriteString(lineBreak)
+	}
+	s.WriteString("}")
+	s.WriteString(lineBreak)
+	s.WriteString(lineBreak)
+}
+
+// getArrayFilterTypes returns the element types of the array columns in alphabetical order e.g. Int and String
+func getArrayFilterTypes(models []*Model) []string {
+	var elementTypes []string
+	for _, model := range models {
+		for _, field := range model.Fields {
+			// binary data and custom types can not be filtered on
+			if field.IsBinary || field.IsCustomType {
+				continue
+			}
+			if field.IsList && !sliceContains(elementTypes, field.Type) {
+				elementTypes = append(elementTypes, field.Type)
+			}
+		}
+	}
+	sort.Strings(elementTypes)
+	return elementTypes
+}
+
+func hasJSONFields(models []*Model) bool {
+	for _, model := range models {
+		for _, field := range model.Fields {
+			if field.IsJSON {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func getJSONScalar(config Config) string {
+	if config.JSONScalar == "" {
+		return "JSON"
+	}
+	return config.JSONScalar
+}
+
+// isHiddenTenantField returns true if the field is the tenant field which is not on the output types
+func isHiddenTenantField(field *Field, config Config) bool {
+	return field.IsTenant && !config.TenantFieldInOutput
+}
+
+func hasTenantFields(models []*Model) bool {
+	for _, model := range models {
+		if modelHasTenantField(model) {
+			return true
+		}
+	}
+	return false
+}
+
+func modelHasTenantField(model *Model) bool {
+	for _, field := range model.Fields {
+		if field.IsTenant {
+			return true
+		}
+	}
+	return false
+}
+
+func getTenantDirective(config Config) string {
+	if config.TenantDirective == "" {
+		return "tenantScoped"
+	}
+	return config.TenantDirective
+}
+
+// getModelDirectives returns the directives of the operations of a model e.g. @isAuthenticated @tenantScoped
+func getModelDirectives(joinedDirectives string, model *Model, config Config) string {
+	if !modelHasTenantField(model) {
+		return joinedDirectives
+	}
+	return strings.TrimSpace(joinedDirectives + " @" + getTenantDirective(config))
+}
+
+// hasSoftDelete returns true if the model has a deleted_at column
+func hasSoftDelete(model *Model) bool {
+	for _, field := range model.Fields {
+		if field.IsSoftDelete {
+			return true
+		}
+	}
+	return false
+}
+
+func hasHardDeleteMutations(models []*Model, config Config) bool {
+	if !config.Mutations || config.HardDeleteDirective == "" {
+		return false
+	}
+	for _, model := range models {
+		if hasSoftDelete(model) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasBinaryFields(models []*Model) bool {
+	for _, model := range models {
+		if modelHasBinaryFields(model) {
+			return true
+		}
+	}
+	return false
+}
+
+func modelHasBinaryFields(model *Model) bool {
+	for _, field := range model.Fields {
+		if field.IsBinary {
+			return true
+		}
+	}
+	return false
+}
+
+// hasListItemType returns true if list queries of the model return {Model}ListItem instead of the model
+func hasListItemType(model *Model, config Config) bool {
+	return config.OmitBinaryFromLists && modelHasBinaryFields(model)
+}
+
+// getCustomTypeScalars returns the scalars of the custom type fields in alphabetical order e.g. Point, scalars which
+// are declared already e.g. String or JSON are left out
+func getCustomTypeScalars(models []*Model, config Config) []string {
+	var declaredScalars []string
+	if hasJSONFields(models) {
+		declaredScalars = append(declaredScalars, getJSONScalar(config))
+	}
+	if hasBinaryFields(models) {
+		declaredScalars = append(declaredScalars, getBinaryScalars(config)...)
+	}
+	var scalars []string
+	for _, model := range models {
+		for _, field := range model.Fields {
+			if !field.IsCustomType || isBuiltInScalar(field.Type) || sliceContains(declaredScalars, field.Type) ||
+				sliceContains(scalars, field.Type) {
+				continue
+			}
+			scalars = append(scalars, field.Type)
+		}
+	}
+	sort.Strings(scalars)
+	return scalars
+}
+
+func isBuiltInScalar(typeName string) bool {
+	return sliceContains([]string{"ID", "String", "Int", "Float", "Boolean"}, typeName)
+}
+
+func getBinaryScalar(config Config) string {
+	if config.BinaryScalar == "" {
+		return "Base64"
+	}
+	return config.BinaryScalar
+}
+
+func getBinaryInputScalar(config Config) string {
+	if config.BinaryInputScalar == "" {
+		return getBinaryScalar(config)
+	}
+	return config.BinaryInputScalar
+}
+
+// getBinaryScalars returns the scalars of binary fields e.g. Base64 and Upload if the input scalar is used
+func getBinaryScalars(config Config) []string {
+	scalars := []string{getBinaryScalar(config)}
+	if config.Mutations && getBinaryInputScalar(config) != scalars[0] {
+		scalars = append(scalars, getBinaryInputScalar(config))
+	}
+	return scalars
+}
+
+// getInputType returns the type of the field in inputs e.g. Upload! instead of Base64! for binary fields
+func getInputType(field *Field, fullType string, config Config) string {
+	if !field.IsBinary {
+		return fullType
+	}
+	return strings.Replace(fullType, field.Type, getBinaryInputScalar(config), 1)
+}
+
+// writeDescription writes a GraphQL description e.g. """The email address of the user""" above a type or field
+func writeDescription(s *strings.Builder, prefix string, description string) {
+	if description == "" {
+		return
+	}
+	s.WriteString(prefix + `"""` + strings.ReplaceAll(description, `"""`, `\"""`) + `"""`)
+	s.WriteString(lineBreak)
+}
+
+func getFullType(fieldType string, isArray bool, isRequired bool) string {
+	gType := fieldType
+
+	if isArray {
+		// To use a list type, surround the type in square brackets, so [Int] is a list of integers.
+		gType = "[" + gType + "]"
+	}
+	if isRequired {
+		// Use an exclamation point to indicate a type cannot be nullable,
+		// so String! is a non-nullable string.
+		gType += "!"
+	}
+	return gType
+}
+
+// modelConverter converts the sqlboiler models to the models of the schema
+type modelConverter struct {
+	names                initialisms
+	jsonScalar           string
+	binaryScalar         string
+	customTypeScalar     string
+	autoTimestampColumns []string
+	tenantField          string
+}
+
+func newModelConverter(config Config) *modelConverter {
+	autoTimestampColumns := config.AutoTimestampColumns
+	if autoTimestampColumns == nil {
+		autoTimestampColumns = DefaultAutoTimestampColumns
+	}
+	return &modelConverter{
+		names:                newInitialisms(config.Initialisms),
+		jsonScalar:           getJSONScalar(config),
+		binaryScalar:         getBinaryScalar(config),
+		customTypeScalar:     config.CustomTypeScalar,
+		autoTimestampColumns: autoTimestampColumns,
+		tenantField:          config.TenantField,
+	}
+}
+
+func (c *modelConverter) boilerModelsToModels(
+	boilerModels []*gqlgen_sqlboiler.BoilerModel,
+	goModels map[string]*goModel,
+) []*Model {
+	models := make([]*Model, len(boilerModels))
+	for i, boilerModel := range boilerModels {
+		goModel := goModels[boilerModel.Name]
+		models[i] = &Model{
+			Name:   boilerModel.Name,
+			Fields: c.boilerFieldsToFields(addBinaryBoilerFields(boilerModel.Fields, goModel), goModel),
+		}
+	}
+	return models
+}
+
+func (c *modelConverter) boilerFieldsToFields(boilerFields []*gqlgen_sqlboiler.BoilerField, goModel *goModel) []*Field {
+	sortTimestampFieldsLast(boilerFields)
+	fields := make([]*Field, len(boilerFields))
+	for i, boilerField := range boilerFields {
+		fields[i] = c.boilerFieldToField(boilerField, goModel)
+	}
+	return fields
+}
+
+func (c *modelConverter) boilerFieldToField(boilerField *gqlgen_sqlboiler.BoilerField, goModel *goModel) *Field {
+	var relationName string
+	var relationType string
+	var relationFullType string
+	if boilerField.Relationship != nil {
+		relationName = c.names.toLowerCamel(boilerField.RelationshipName)
+		relationType = boilerField.Relationship.Name
+
+		relationFullType = getFullType(
+			relationType,
+			boilerField.IsArray,
+			boilerField.IsRequired,
+		)
+	}
+
+	t := toGraphQLType(boilerField.Type, nil)
+	var description, goType string
+	var tag reflect.StructTag
+	var isPrimaryKey, hasDefault, isList, isJSON, isBinary, isCustomType, isSoftDelete, isAutoTimestamp, isTenant bool
+	if goField := goModel.getField(boilerField.Name); goField != nil {
+		description = goField.Doc
+		goType = goField.TypeName
+		tag = goField.Tag
+		t = toGraphQLType(goField.TypeName, goField.Type)
+		isPrimaryKey = goModel.isPrimaryKey(goField.columnName())
+		hasDefault = goModel.hasDefault(goField.columnName())
+		isList = isListType(goField.TypeName)
+		isSoftDelete = goField.columnName() == "deleted_at"
+		isAutoTimestamp = sliceContains(c.autoTimestampColumns, goField.columnName())
+		isTenant = c.tenantField != "" && goField.columnName() == c.tenantField
+		if isJSONType(goField.TypeName) {
+			isJSON = true
+			t = c.jsonScalar
+		}
+		if isBinaryType(goField.TypeName) {
+			isBinary = true
+			t = c.binaryScalar
+		}
+		// e.g. a Point struct which has no GraphQL type
+		if !isJSON && !isBinary && !isBuiltInScalar(t) {
+			isCustomType = true
+			if c.customTypeScalar != "" {
+				t = c.customTypeScalar
+			}
+		}
+	}
+
+	// only foreign keys of a relationship of the model, relationships themselves have no column
+	isForeignKey := boilerField.IsForeignKey && boilerField.IsRelation && boilerField.Relationship != nil
+	if isPrimaryKey || isForeignKey {
+		t = "ID"
+	}
+
+	// e.g. [String!]! for a not null text[] column
+	fullType := getFullType(t, boilerField.IsArray, boilerField.IsRequired)
+	fullTypeOptional := getFullType(t, boilerField.IsArray, false)
+	if isList {
+		fullType = getFullType(t+"!", true, boilerField.IsRequired)
+		fullTypeOptional = getFullType(t+"!", true, false)
+	}
+	return &Field{
+		Name:             c.names.toLowerCamel(boilerField.Name),
+		RelationName:     relationName,
+		RelationType:     relationType,
+		Type:             t,
+		FullType:         fullType,
+		FullTypeOptional: fullTypeOptional,
+		RelationFullType: relationFullType,
+		Description:      description,
+		GoType:           goType,
+		Tag:              tag,
+		IsPrimaryKey:     isPrimaryKey,
+		IsForeignKey:     isForeignKey,
+		HasDefault:       hasDefault,
+		IsList:           isList,
+		IsJSON:           isJSON,
+		IsBinary:         isBinary,
+		IsCustomType:     isCustomType,
+		IsSoftDelete:     isSoftDelete,
+		IsAutoTimestamp:  isAutoTimestamp,
+		IsTenant:         isTenant,
+		BoilerField:      boilerField,
+	}
+}
+
+// addBinaryBoilerFields adds the binary fields e.g. []byte which gqlgen-sqlboiler skips since they are arrays, they
+// are added after the field which is above them in the struct
+func addBinaryBoilerFields(
+	boilerFields []*gqlgen_sqlboiler.BoilerField,
+	goModel *goModel,
+) []*gqlgen_sqlboiler.BoilerField {
+	if goModel == nil {
+		return boilerFields
+	}
+	existing := map[string]bool{}
+	for _, boilerField := range boilerFields {
+		existing[boilerField.Name] = true
+	}
+
+	// the missing fields per field which is above them, the empty name for the ones at the top of the struct
+	missing := map[string][]*gqlgen_sqlboiler.BoilerField{}
+	var above string
+	for _, name := range goModel.FieldNames {
+		if existing[name] {
+			above = name
+			continue
+		}
+		goField := goModel.Fields[name]
+		if !isBinaryType(goField.TypeName) || goField.columnName() == "" || goField.columnName() == "-" {
+			continue
+		}
+		missing[above] = append(missing[above], &gqlgen_sqlboiler.BoilerField{
+			Name:       name,
+			Type:       goField.TypeName,
+			IsRequired: !strings.HasPrefix(goField.TypeName, "*"),
+		})
+	}
+	if len(missing) == 0 {
+		return boilerFields
+	}
+
+	result := missing[""]
+	for _, boilerField := range boilerFields {
+		result = append(result, boilerField)
+		result = append(result, missing[boilerField.Name]...)
+	}
+	return result
+}
+
+// sortTimestampFieldsLast puts createdAt, updatedAt and deletedAt last and in that order. sqlboiler models are already
+// sorted like this but not in a stable way so the order of these fields could change every time this program has ran.
+func sortTimestampFieldsLast(boilerFields []*gqlgen_sqlboiler.BoilerField) {
+	timestampOrder := func(name string) int {
+		for i, suffix := range []string{"createdat", "updatedat", "deletedat"} {
+			if strings.HasSuffix(strings.ToLower(name), suffix) {
+				return i + 1
+			}
+		}
+		return 0
+	}
+	sort.SliceStable(boilerFields, func(i, j int) bool {
+		return timestampOrder(boilerFields[i].Name) < timestampOrder(boilerFields[j].Name)
+	})
+}
+
+func fieldsWithout(fields []*Field, skipFieldNames []string) []*Field {
+	var filteredFields []*Field
+	for _, field := range fields {
+		if !sliceContains(skipFieldNames, field.Name) {
+			filteredFields = append(filteredFields, field)
+		}
+	}
+	return filteredFields
+}
+
+func sliceContains(slice []string, v string) bool {
+	for _, s := range slice {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,354 @@
+package schema
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata/golden") //nolint:gochecknoglobals
+
+var fixtures = []string{ //nolint:gochecknoglobals
+	"social-network",
+	"tree",
+	"composite-keys",
+	"nullable-relations",
+	"enums",
+	"column-types",
+	"soft-delete",
+}
+
+type goldenCase struct {
+	name   string
+	config Config
+}
+
+// getGoldenCases returns every combination of the mutation flags and the other flags on top of the default config
+func getGoldenCases(modelDirectory string) []goldenCase {
+	cases := []goldenCase{
+		{name: "queries-only", config: Config{ModelDirectory: modelDirectory}},
+	}
+
+	for i := 0; i < 8; i++ {
+		name := "mutations"
+		config := Config{ModelDirectory: modelDirectory, Mutations: true}
+		if i&1 != 0 {
+			name += "-batch-create"
+			config.BatchCreate = true
+		}
+		if i&2 != 0 {
+			name += "-batch-update"
+			config.BatchUpdate = true
+		}
+		if i&4 != 0 {
+			name += "-batch-delete"
+			config.BatchDelete = true
+		}
+		cases = append(cases, goldenCase{name: name, config: config})
+	}
+
+	all := Config{
+		ModelDirectory: modelDirectory,
+		Mutations:      true,
+		BatchCreate:    true,
+		BatchUpdate:    true,
+		BatchDelete:    true,
+	}
+
+	pagination := all
+	pagination.Pagination = "offset"
+	cases = append(cases, goldenCase{name: "pagination-offset", config: pagination})
+
+	directives := all
+	directives.Directives = []string{"isAuthenticated", "hasRole"}
+	directives.SkipInputFields = []string{"userId", "updatedAt"}
+	cases = append(cases, goldenCase{name: "directives-skip-input-fields", config: directives})
+
+	return cases
+}
+
+func TestGenerateGolden(t *testing.T) {
+	for _, fixture := range fixtures {
+		for _, c := range getGoldenCases(filepath.Join("testdata", fixture)) {
+			c := c
+			t.Run(fixture+"/"+c.name, func(t *testing.T) {
+				document, err := Generate(c.config)
+				if err != nil {
+					t.Fatalf("could not generate schema: %v", err)
+				}
+
+				assertGolden(t, filepath.Join("testdata", "golden", fixture, c.name+".graphql"), document.SDL)
+			})
+		}
+	}
+}
+
+// assertGolden compares the schema with the golden file or updates the golden file if -update is given
+func assertGolden(t *testing.T, goldenFile string, schema string) {
+	t.Helper()
+
+	if _, err := gqlparser.LoadSchema(&ast.Source{Name: goldenFile, Input: schema}); err != nil {
+		t.Errorf("generated schema is invalid: %v", err)
+	}
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(goldenFile), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(goldenFile, []byte(schema), 0644); err != nil { //nolint:gosec
+			t.Fatal(err)
+		}
+		return
+	}
+
+	golden, err := ioutil.ReadFile(goldenFile)
+	if err != nil {
+		t.Fatalf("could not read golden file, run go test ./schema -update to create it: %v", err)
+	}
+	if string(golden) != schema {
+		t.Errorf("generated schema differs from %v, run go test ./schema -update if this is expected\n%v",
+			goldenFile, schema)
+	}
+}
+
+// generateSchema generates the schema of the config and fails the test if it can not be generated
+func generateSchema(t *testing.T, config Config) *Document {
+	t.Helper()
+	document, err := Generate(config)
+	if err != nil {
+		t.Fatalf("could not generate schema: %v", err)
+	}
+	return document
+}
+
+// assertSchemaContains checks that the schema contains all expected strings
+func assertSchemaContains(t *testing.T, schema string, expected ...string) {
+	t.Helper()
+	for _, e := range expected {
+		if !strings.Contains(schema, e) {
+			t.Errorf("expected schema to contain %q", e)
+		}
+	}
+}
+
+func assertValidSchema(t *testing.T, schema string) {
+	t.Helper()
+	if _, err := gqlparser.LoadSchema(&ast.Source{Name: "schema.graphql", Input: schema}); err != nil {
+		t.Errorf("generated schema is invalid: %v", err)
+	}
+}
+
+// getDefinition returns the definition which starts with e.g. input UserWhere { up to its closing brace and fails the
+// test if the schema has no such definition
+func getDefinition(t *testing.T, schema string, start string) string {
+	t.Helper()
+	i := strings.Index(schema, start)
+	if i == -1 {
+		t.Fatalf("expected schema to contain %q", start)
+	}
+	definition := schema[i:]
+	if end := strings.Index(definition, "}"); end != -1 {
+		definition = definition[:end+1]
+	}
+	return definition
+}
+
+func TestJSONScalar(t *testing.T) {
+	document := generateSchema(t, Config{ModelDirectory: filepath.Join("testdata", "column-types"),
+		JSONScalar: "JSONObject"})
+	assertSchemaContains(t, document.SDL, "scalar JSONObject", "input JSONObjectFilter {", "metadata: JSONObject!",
+		"settings: JSONObject\n", "settings: JSONObjectFilter")
+	assertValidSchema(t, document.SDL)
+}
+
+func TestBinaryScalars(t *testing.T) {
+	document := generateSchema(t, Config{
+		ModelDirectory:      filepath.Join("testdata", "column-types"),
+		Mutations:           true,
+		BinaryInputScalar:   "Upload",
+		OmitBinaryFromLists: true,
+	})
+	assertSchemaContains(t, document.SDL, "scalar Base64", "scalar Upload", "image: Base64!", "thumb: Upload\n",
+		"pages: [Upload!]!", "type ProductListItem {", "products(filter: ProductFilter): [ProductListItem!]!")
+	listItem := getDefinition(t, document.SDL, "type ProductListItem {")
+	whereInput := getDefinition(t, document.SDL, "input ProductWhere {")
+	for _, binaryField := range []string{"image", "thumb", "pages"} {
+		if strings.Contains(listItem, binaryField+":") || strings.Contains(whereInput, binaryField+":") {
+			t.Errorf("expected %v to be omitted from the list item and where input", binaryField)
+		}
+	}
+	assertValidSchema(t, document.SDL)
+}
+
+func TestHardDeleteDirective(t *testing.T) {
+	document := generateSchema(t, Config{
+		ModelDirectory:      filepath.Join("testdata", "soft-delete"),
+		Mutations:           true,
+		BatchDelete:         true,
+		HardDeleteDirective: "isAdmin",
+	})
+	assertSchemaContains(t, document.SDL, "directive @isAdmin on FIELD_DEFINITION",
+		"hardDeleteAccount(id: ID!): AccountDeletePayload! @isAdmin",
+		"hardDeleteDocuments(filter: DocumentFilter): DocumentsDeletePayload! @isAdmin")
+	// audit logs are not soft deleted so a delete is already a hard delete
+	if strings.Contains(document.SDL, "hardDeleteAuditLog") || strings.Contains(document.SDL, "restoreAuditLog") {
+		t.Error("expected no hard delete or restore mutations for models without a deleted_at column")
+	}
+	assertValidSchema(t, document.SDL)
+}
+
+func TestAutoTimestampColumns(t *testing.T) {
+	document := generateSchema(t, Config{
+		ModelDirectory:       filepath.Join("testdata", "social-network"),
+		Mutations:            true,
+		AutoTimestampColumns: []string{"updated_at"},
+	})
+	createInput := getDefinition(t, document.SDL, "input UserCreateInput {")
+	if !strings.Contains(createInput, "createdAt: Int") || strings.Contains(createInput, "updatedAt") {
+		t.Errorf("expected only updatedAt to be filled by sqlboiler but got %v", createInput)
+	}
+	whereInput := getDefinition(t, document.SDL, "input UserWhere {")
+	if !strings.Contains(whereInput, "updatedAt: IntFilter") {
+		t.Errorf("expected updatedAt to be filterable but got %v", whereInput)
+	}
+	assertSchemaContains(t, getDefinition(t, document.SDL, "input FriendshipCreateInput {"), "createdAt: Int")
+	assertValidSchema(t, document.SDL)
+
+	// a friendship has no columns left to create when created_at is filled by sqlboiler as well
+	document = generateSchema(t, Config{
+		ModelDirectory: filepath.Join("testdata", "social-network"),
+		Mutations:      true,
+		BatchCreate:    true,
+	})
+	if strings.Contains(document.SDL, "FriendshipCreateInput") || strings.Contains(document.SDL, "createFriendship") {
+		t.Error("expected no create mutation for a model without create input fields")
+	}
+	assertSchemaContains(t, document.SDL, "deleteFriendship(id: ID!): FriendshipDeletePayload!")
+	assertValidSchema(t, document.SDL)
+}
+
+func TestBatchPayloadRecords(t *testing.T) {
+	document := generateSchema(t, Config{
+		ModelDirectory:            filepath.Join("testdata", "tree"),
+		Mutations:                 true,
+		BatchUpdate:               true,
+		BatchDelete:               true,
+		BatchUpdatePayloadRecords: true,
+	})
+	assertSchemaContains(t, getDefinition(t, document.SDL, "type CategoriesUpdatePayload {"), "ok: Boolean!",
+		"ids: [ID!]!", "affectedRows: Int!", "categories: [Category!]!")
+	deletePayload := getDefinition(t, document.SDL, "type CategoriesDeletePayload {")
+	if !strings.Contains(deletePayload, "affectedRows: Int!") || strings.Contains(deletePayload, "categories") {
+		t.Errorf("expected delete payload with affected rows but without records but got %v", deletePayload)
+	}
+	assertValidSchema(t, document.SDL)
+}
+
+func TestBatchUpdateByIds(t *testing.T) {
+	document := generateSchema(t, Config{
+		ModelDirectory: filepath.Join("testdata", "social-network"),
+		Mutations:      true,
+		BatchUpdate:    true,
+	})
+	assertSchemaContains(t, document.SDL,
+		"updateUsersByIds(input: [UserBatchUpdateItem!]!): UsersBatchUpdatePayload!")
+	item := getDefinition(t, document.SDL, "input UserBatchUpdateItem {")
+	if item != "input UserBatchUpdateItem {\n\tid: ID!\n\tinput: UserUpdateInput!\n}" {
+		t.Errorf("expected an item with the id and the update input but got %v", item)
+	}
+	// every field is optional so an item only changes what it contains, sqlboiler fills the timestamps
+	updateInput := getDefinition(t, document.SDL, "input UserUpdateInput {")
+	if updateInput != "input UserUpdateInput {\n\tfirstName: String\n\tlastName: String\n\temail: String\n}" {
+		t.Errorf("expected optional update fields without timestamps but got %v", updateInput)
+	}
+	payload := getDefinition(t, document.SDL, "type UsersBatchUpdatePayload {")
+	if payload != "type UsersBatchUpdatePayload {\n\tusers: [User!]!\n}" {
+		t.Errorf("expected a payload with the updated users but got %v", payload)
+	}
+	assertValidSchema(t, document.SDL)
+
+	// the tenant of a record can not be changed
+	document = generateSchema(t, Config{
+		ModelDirectory: filepath.Join("testdata", "nullable-relations"),
+		Mutations:      true,
+		BatchUpdate:    true,
+		TenantField:    "organization_id",
+	})
+	assertSchemaContains(t, getDefinition(t, document.SDL, "input UserBatchUpdateItem {"),
+		"input: UserUpdateInput!")
+	if updateInput := getDefinition(t, document.SDL, "input UserUpdateInput {"); strings.Contains(updateInput,
+		"organization") {
+		t.Errorf("expected the tenant field not to be updatable but got %v", updateInput)
+	}
+	assertValidSchema(t, document.SDL)
+}
+
+func TestMutationErrors(t *testing.T) {
+	modelDirectory := filepath.Join("testdata", "social-network")
+	tests := []struct {
+		mutationErrors string
+		expected       []string
+	}{
+		{mutationErrors: "payload", expected: []string{"type UserError {", "user: User\n\terrors: [UserError!]",
+			"createUser(input: UserCreateInput!): UserPayload!"}},
+		{mutationErrors: "union", expected: []string{"type ValidationError {", "type NotFoundError {",
+			"union CreateUserResult = UserPayload | ValidationError | NotFoundError",
+			"union DeleteUserResult = UserDeletePayload | NotFoundError",
+			"createUser(input: UserCreateInput!): CreateUserResult!",
+			"updateUser(id: ID!, input: UserUpdateInput!): UpdateUserResult!",
+			"deleteFriendship(id: ID!): DeleteFriendshipResult!"}},
+	}
+	for _, test := range tests {
+		document := generateSchema(t, Config{ModelDirectory: modelDirectory, Mutations: true,
+			MutationErrors: test.mutationErrors})
+		assertSchemaContains(t, document.SDL, test.expected...)
+		assertValidSchema(t, document.SDL)
+	}
+
+	// the restore and hard delete mutations of soft deleted models return results as well
+	document := generateSchema(t, Config{ModelDirectory: filepath.Join("testdata", "soft-delete"), Mutations: true,
+		MutationErrors: "union", HardDeleteDirective: "isAdmin"})
+	assertSchemaContains(t, document.SDL, "union RestoreAccountResult = AccountPayload | NotFoundError",
+		"union HardDeleteAccountResult = AccountDeletePayload | NotFoundError",
+		"restoreAccount(id: ID!): RestoreAccountResult!",
+		"hardDeleteAccount(id: ID!): HardDeleteAccountResult! @isAdmin")
+	if strings.Contains(document.SDL, "RestoreAuditLogResult") {
+		t.Error("expected no restore result for models without a deleted_at column")
+	}
+	assertValidSchema(t, document.SDL)
+
+	if _, err := Generate(Config{ModelDirectory: modelDirectory, MutationErrors: "exceptions"}); err == nil {
+		t.Error("expected an error for unknown mutation errors")
+	}
+}
+
+func TestTenantField(t *testing.T) {
+	config := Config{
+		ModelDirectory: filepath.Join("testdata", "nullable-relations"),
+		Mutations:      true,
+		TenantField:    "organization_id",
+	}
+	document := generateSchema(t, config)
+	assertSchemaContains(t, document.SDL, "directive @tenantScoped on FIELD_DEFINITION",
+		"users(filter: UserFilter): [User!]!@tenantScoped",
+		"createUser(input: UserCreateInput!): UserPayload!@tenantScoped",
+		"organizations(filter: OrganizationFilter): [Organization!]!\n")
+	for _, typeName := range []string{"type User {", "input UserWhere {", "input UserCreateInput {",
+		"input UserUpdateInput {"} {
+		if definition := getDefinition(t, document.SDL, typeName); strings.Contains(definition, "organization") {
+			t.Errorf("expected the tenant field to be removed from %v but got %v", typeName, definition)
+		}
+	}
+	assertValidSchema(t, document.SDL)
+
+	config.TenantFieldInOutput = true
+	config.TenantDirective = "hasOrganization"
+	document = generateSchema(t, config)
+	assertSchemaContains(t, document.SDL, "\torganization: Organization\n", "user(id: ID!): User!@hasOrganization")
+}
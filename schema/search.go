@@ -0,0 +1,166 @@
+package schema
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SearchModes are the ways the search of a filter could match e.g. FULL_TEXT
+var SearchModes = []string{"CONTAINS", "PREFIX", "FULL_TEXT"} //nolint:gochecknoglobals
+
+// ModelSearch configures the search field of the filter of a model
+type ModelSearch struct {
+	Disabled bool            // drops search from the filter e.g. for models which could not be searched
+	Columns  []*SearchColumn // columns which are searched e.g. first_name and last_name
+	Mode     string          // one of SearchModes, empty leaves it to the resolver
+}
+
+// SearchColumn is a searched column together with its weight in the ranking, 0 is the default weight
+type SearchColumn struct {
+	Name   string
+	Weight float64
+}
+
+// setModelSearch sets the search config of the models by their name
+func setModelSearch(models []*Model, search map[string]*ModelSearch) error {
+	var names []string
+	for name := range search {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		modelSearch := search[name]
+		if modelSearch.Mode != "" && !sliceContains(SearchModes, modelSearch.Mode) {
+			return fmt.Errorf("unknown search mode %v of model %v, expected one of %v", modelSearch.Mode, name,
+				strings.Join(SearchModes, ", "))
+		}
+
+		model := findModel(models, name)
+		if model == nil {
+			fmt.Printf("[warn] search is configured for model %v which does not exist\n", name)
+			continue
+		}
+		for _, column := range modelSearch.Columns {
+			if !modelHasColumn(model, column.Name) {
+				fmt.Printf("[warn] search column %v of model %v does not exist\n", column.Name, name)
+			}
+		}
+		model.Search = modelSearch
+	}
+	return nil
+}
+
+func findModel(models []*Model, name string) *Model {
+	for _, model := range models {
+		if model.Name == name {
+			return model
+		}
+	}
+	return nil
+}
+
+func modelHasColumn(model *Model, column string) bool {
+	for _, field := range model.Fields {
+		if getColumnName(field.Tag) == column {
+			return true
+		}
+	}
+	return false
+}
+
+// hasSearchDirectives returns true if a model has search columns
+func hasSearchDirectives(models []*Model) bool {
+	for _, model := range models {
+		if model.Search != nil && !model.Search.Disabled && len(model.Search.Columns) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// getSearchField returns the search field of the filter of a model e.g.
+// search: SearchInput @search(columns: [{name: "first_name", weight: 2}], mode: FULL_TEXT)
+func getSearchField(model *Model, config Config) string {
+	if model.Search != nil && model.Search.Disabled {
+		return ""
+	}
+	searchType := "String"
+	if config.SearchInput {
+		searchType = "SearchInput"
+	}
+	if model.Search == nil || len(model.Search.Columns) == 0 {
+		return "search: " + searchType
+	}
+
+	columns := make([]string, len(model.Search.Columns))
+	for i, column := range model.Search.Columns {
+		columns[i] = `{name: "` + column.Name + `"`
+		if column.Weight != 0 {
+			columns[i] += ", weight: " + strconv.FormatFloat(column.Weight, 'f', -1, 64)
+		}
+		columns[i] += "}"
+	}
+	directive := "@search(columns: [" + strings.Join(columns, ", ") + "]"
+	if model.Search.Mode != "" {
+		directive += ", mode: " + model.Search.Mode
+	}
+	return "search: " + searchType + " " + directive + ")"
+}
+
+// writeSearchTypes writes the types of the search directive and SearchInput
+func writeSearchTypes(s *strings.Builder, models []*Model, config Config) {
+	if !config.SearchInput && !hasSearchDirectives(models) {
+		return
+	}
+	s.WriteString("enum SearchMode {")
+	s.WriteString(lineBreak)
+	for _, mode := range SearchModes {
+		s.WriteString(indent + mode)
+		s.WriteString(lineBreak)
+	}
+	s.WriteString("}")
+	s.WriteString(lineBreak)
+	s.WriteString(lineBreak)
+
+	if config.SearchInput {
+		s.WriteString("input SearchInput {")
+		s.WriteString(lineBreak)
+		s.WriteString(indent + "query: String!")
+		s.WriteString(lineBreak)
+		s.WriteString(indent + "mode: SearchMode")
+		s.WriteString(lineBreak)
+		s.WriteString("}")
+		s.WriteString(lineBreak)
+		s.WriteString(lineBreak)
+	}
+
+	if hasSearchDirectives(models) {
+		s.WriteString("input SearchColumn {")
+		s.WriteString(lineBreak)
+		s.WriteString(indent + "name: String!")
+		s.WriteString(lineBreak)
+		s.WriteString(indent + "weight: Float")
+		s.WriteString(lineBreak)
+		s.WriteString("}")
+		s.WriteString(lineBreak)
+		s.WriteString(lineBreak)
+	}
+}
+
+// getSearchTypeNames returns the names of the types which writeSearchTypes generates
+func getSearchTypeNames(models []*Model, config Config) []string {
+	var names []string
+	if config.SearchInput || hasSearchDirectives(models) {
+		names = append(names, "SearchMode")
+	}
+	if config.SearchInput {
+		names = append(names, "SearchInput")
+	}
+	if hasSearchDirectives(models) {
+		names = append(names, "SearchColumn")
+	}
+	return names
+}
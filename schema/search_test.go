@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	config := Config{
+		ModelDirectory: filepath.Join("testdata", "social-network"),
+		SearchInput:    true,
+		Search: map[string]*ModelSearch{
+			"User": {
+				Columns: []*SearchColumn{{Name: "first_name", Weight: 2}, {Name: "last_name"}},
+				Mode:    "FULL_TEXT",
+			},
+			"Like": {Disabled: true},
+		},
+	}
+	document := generateSchema(t, config)
+	assertSchemaContains(t, document.SDL,
+		"directive @search(columns: [SearchColumn!]!, mode: SearchMode) on INPUT_FIELD_DEFINITION",
+		"input SearchInput {\n\tquery: String!\n\tmode: SearchMode\n}",
+		`search: SearchInput @search(columns: [{name: "first_name", weight: 2}, {name: "last_name"}], mode: FULL_TEXT)`,
+		"input LikeFilter {\n\twhere: LikeWhere",
+		"input PostFilter {\n\tsearch: SearchInput\n",
+	)
+	assertValidSchema(t, document.SDL)
+
+	config.Search["User"].Mode = "FUZZY"
+	if _, err := Generate(config); err == nil {
+		t.Error("expected an error for an unknown search mode")
+	}
+}
@@ -0,0 +1,40 @@
+CREATE TABLE organizations (
+    id INTEGER PRIMARY KEY,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    website_url TEXT
+);
+
+CREATE TABLE users (
+    id INTEGER PRIMARY KEY,
+    organization_id INTEGER NOT NULL REFERENCES organizations (id),
+    manager_id INTEGER REFERENCES users (id),
+    email VARCHAR(255) NOT NULL CHECK (length(email) > 3),
+    first_name TEXT NOT NULL,
+    age INTEGER CHECK (age >= 0 AND age <= 150),
+    is_admin BOOLEAN NOT NULL DEFAULT 0,
+    balance DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME
+);
+
+CREATE UNIQUE INDEX users_email ON users (email);
+
+CREATE TABLE posts (
+    id INTEGER PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users (id),
+    title VARCHAR(140) NOT NULL,
+    body TEXT,
+    rating REAL,
+    CHECK (rating BETWEEN 0 AND 5)
+);
+
+CREATE TABLE tags (
+    id INTEGER PRIMARY KEY,
+    name TEXT NOT NULL
+);
+
+CREATE TABLE post_tags (
+    post_id INTEGER NOT NULL REFERENCES posts (id),
+    tag_id INTEGER NOT NULL REFERENCES tags (id),
+    PRIMARY KEY (post_id, tag_id)
+);
@@ -0,0 +1,557 @@
+package schema
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	gqlgen_sqlboiler "github.com/web-ridge/gqlgen-sqlboiler/v2"
+	"golang.org/x/tools/go/packages"
+)
+
+// Constant is a constant declared in the models package e.g. the enum value OrderStatusPending = "pending"
+type Constant struct {
+	Name  string
+	Type  string // e.g. string or OrderStatus
+	Value string // e.g. pending
+	Doc   string // e.g. Enum values for order_status
+}
+
+// goPackage is the Go type information of the models package
+type goPackage struct {
+	Models    map[string]*goModel // per struct name
+	Constants []*Constant
+}
+
+// goModel is the Go type information of a model together with the column metadata sqlboiler generates next to it
+type goModel struct {
+	Fields             map[string]*goField // per struct field name
+	FieldNames         []string            // in the order of the struct
+	PrimaryKeyColumns  []string            // e.g. id, from userPrimaryKeyColumns
+	ColumnsWithDefault []string            // e.g. id and created_at, from userColumnsWithDefault
+	// ForeignKeys are the to-one relationships per foreign key field e.g. Owner for OwnerUUID, from the relationship
+	// struct userR and the field the relationship method filters on, nil if the model has no relationship struct
+	ForeignKeys map[string]*goForeignKey
+}
+
+// goForeignKey is a to-one relationship of a model e.g. Owner *User of the foreign key OwnerUUID
+type goForeignKey struct {
+	RelationshipName string // e.g. Owner
+	ModelName        string // e.g. User
+}
+
+// getField returns nil for relations since they are not a field of the model struct
+func (m *goModel) getField(name string) *goField {
+	if m == nil {
+		return nil
+	}
+	return m.Fields[name]
+}
+
+// isPrimaryKey falls back to the id column if the primary key of the model is unknown
+func (m *goModel) isPrimaryKey(column string) bool {
+	if m == nil || m.PrimaryKeyColumns == nil {
+		return column == "id"
+	}
+	return sliceContains(m.PrimaryKeyColumns, column)
+}
+
+func (m *goModel) hasDefault(column string) bool {
+	return m != nil && sliceContains(m.ColumnsWithDefault, column)
+}
+
+// goField is the Go type information of a struct field of a model
+type goField struct {
+	TypeName string     // e.g. github.com/volatiletech/null/v8.String, time.Time or Point for types in the models package
+	Type     types.Type // nil if the type could not be resolved e.g. because the package could not be imported
+	Tag      reflect.StructTag
+	Doc      string
+}
+
+func (f *goField) columnName() string {
+	return getColumnName(f.Tag)
+}
+
+// getColumnName returns the column of the boil tag of a struct field e.g. email for boil:"email"
+func getColumnName(tag reflect.StructTag) string {
+	return strings.Split(tag.Get("boil"), ",")[0]
+}
+
+// loadGoPackage loads and type checks the models directory with the module it is in. Imports which can not be resolved
+// (e.g. because a dependency of the models is not downloaded) do not fail the type check, the type names of those
+// fields are resolved by their import instead.
+func loadGoPackage(dir string) (*goPackage, error) {
+	config := &packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes | packages.NeedTypesInfo | packages.NeedSyntax,
+		Dir:  dir,
+	}
+	loadedPackages, err := packages.Load(config, ".")
+	if err != nil {
+		return nil, fmt.Errorf("could not load models in %v: %v", dir, err)
+	}
+	if len(loadedPackages) != 1 {
+		return nil, fmt.Errorf("expected one package in %v but found %v, the models should be in a Go module", dir,
+			len(loadedPackages))
+	}
+	loadedPackage := loadedPackages[0]
+	if len(loadedPackage.Syntax) == 0 || loadedPackage.Types == nil || loadedPackage.TypesInfo == nil {
+		return nil, fmt.Errorf("could not load models in %v: %v", dir, loadedPackage.Errors)
+	}
+	files, info, pkg := loadedPackage.Syntax, loadedPackage.TypesInfo, loadedPackage.Types
+
+	result := &goPackage{
+		Models: map[string]*goModel{},
+	}
+	// e.g. userPrimaryKeyColumns = []string{"id"}
+	columnVariables := map[string][]string{}
+	// e.g. Organization -> OrganizationID for func (o *User) Organization(...) which filters on o.OrganizationID
+	relationshipFields := map[string]map[string]string{}
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok {
+				addRelationshipField(relationshipFields, funcDecl)
+				continue
+			}
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			switch genDecl.Tok { //nolint:exhaustive
+			case token.TYPE:
+				for _, spec := range genDecl.Specs {
+					typeSpec := spec.(*ast.TypeSpec)
+					structType, ok := typeSpec.Type.(*ast.StructType)
+					if !ok {
+						continue
+					}
+					fields, fieldNames := getGoFields(structType, file, info, pkg)
+					result.Models[typeSpec.Name.Name] = &goModel{
+						Fields:     fields,
+						FieldNames: fieldNames,
+					}
+				}
+			case token.CONST:
+				result.Constants = append(result.Constants, getConstants(genDecl, info, pkg)...)
+			case token.VAR:
+				addColumnVariables(columnVariables, genDecl)
+			}
+		}
+	}
+
+	// the variables are prefixed with the model name in lower camel case e.g. apiKeyPrimaryKeyColumns for APIKey
+	for name, model := range result.Models {
+		lowerName := strings.ToLower(name)
+		model.PrimaryKeyColumns = columnVariables[lowerName+"PrimaryKeyColumns"]
+		model.ColumnsWithDefault = columnVariables[lowerName+"ColumnsWithDefault"]
+	}
+
+	// the relationship structs are named after the model in lower camel case e.g. apiKeyR for APIKey
+	for name, model := range result.Models {
+		if isFirstCharacterLowerCase(name) {
+			continue
+		}
+		for relationshipsName, relationships := range result.Models {
+			if isFirstCharacterLowerCase(relationshipsName) && strings.EqualFold(relationshipsName, name+"R") {
+				model.ForeignKeys = getForeignKeys(model, relationships, relationshipFields[name])
+			}
+		}
+	}
+	return result, nil
+}
+
+// addRelationshipField adds the field which a relationship method of a model filters on e.g. OrganizationID for
+// func (o *User) Organization(mods ...qm.QueryMod) organizationQuery { ... qm.Where("\"id\" = ?", o.OrganizationID) }
+func addRelationshipField(relationshipFields map[string]map[string]string, funcDecl *ast.FuncDecl) {
+	if funcDecl.Recv == nil || len(funcDecl.Recv.List) != 1 || len(funcDecl.Recv.List[0].Names) != 1 ||
+		funcDecl.Body == nil {
+		return
+	}
+	starExpr, ok := funcDecl.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return
+	}
+	modelName, ok := starExpr.X.(*ast.Ident)
+	if !ok {
+		return
+	}
+	receiver := funcDecl.Recv.List[0].Names[0].Name
+	ast.Inspect(funcDecl.Body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if fun, ok := call.Fun.(*ast.SelectorExpr); !ok || fun.Sel.Name != "Where" {
+			return true
+		}
+		for _, arg := range call.Args {
+			selector, ok := arg.(*ast.SelectorExpr)
+			if !ok {
+				continue
+			}
+			if x, ok := selector.X.(*ast.Ident); ok && x.Name == receiver {
+				if relationshipFields[modelName.Name] == nil {
+					relationshipFields[modelName.Name] = map[string]string{}
+				}
+				relationshipFields[modelName.Name][funcDecl.Name.Name] = selector.Sel.Name
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// getForeignKeys returns the relationship names per foreign key field of the to-one relationships of the model. The
+// field is the one the relationship method filters on or, if the models have no methods, the field of which the
+// column without suffix is the relationship name e.g. owner_uuid for Owner.
+func getForeignKeys(
+	model *goModel,
+	relationships *goModel,
+	relationshipFields map[string]string,
+) map[string]*goForeignKey {
+	foreignKeys := map[string]*goForeignKey{}
+	for _, relationshipName := range relationships.FieldNames {
+		// to-many relationships e.g. Users UserSlice have their foreign key in the other model
+		typeName := relationships.Fields[relationshipName].TypeName
+		if !strings.HasPrefix(typeName, "*") {
+			continue
+		}
+		foreignKey := &goForeignKey{RelationshipName: relationshipName, ModelName: strings.TrimPrefix(typeName, "*")}
+		if fieldName, ok := relationshipFields[relationshipName]; ok {
+			// a one-to-one relationship of which the other model has the foreign key filters on the primary key e.g. id,
+			// primary keys like post_id of post_tags can be a foreign key
+			if field := model.getField(fieldName); field != nil && (!model.isPrimaryKey(field.columnName()) ||
+				trimForeignKeySuffix(field.columnName()) != field.columnName()) {
+				foreignKeys[fieldName] = foreignKey
+			}
+			continue
+		}
+		for _, fieldName := range model.FieldNames {
+			column := model.Fields[fieldName].columnName()
+			if column != "" && trimForeignKeySuffix(column) != column &&
+				strings.ReplaceAll(trimForeignKeySuffix(column), "_", "") == strings.ToLower(relationshipName) {
+				foreignKeys[fieldName] = foreignKey
+			}
+		}
+	}
+	return foreignKeys
+}
+
+// setForeignKeys marks the fields of the boiler models which are a foreign key according to the relationships of the
+// models instead of the name of the field e.g. OwnerUUID is a foreign key and ExternalID is not
+func setForeignKeys(boilerModels []*gqlgen_sqlboiler.BoilerModel, goModels map[string]*goModel) {
+	for _, boilerModel := range boilerModels {
+		goModel := goModels[boilerModel.Name]
+		if goModel == nil || goModel.ForeignKeys == nil {
+			continue
+		}
+		var relationshipsWithForeignKey []string
+		for _, field := range boilerModel.Fields {
+			if field.IsRelation && field.Relationship != nil && !field.IsForeignKey {
+				continue
+			}
+			foreignKey := goModel.ForeignKeys[field.Name]
+			field.IsForeignKey = foreignKey != nil
+			field.IsRelation = foreignKey != nil
+			if foreignKey == nil {
+				field.Relationship = nil
+				continue
+			}
+			field.RelationshipName = foreignKey.RelationshipName
+			field.Relationship = gqlgen_sqlboiler.FindBoilerModel(boilerModels, foreignKey.ModelName)
+			relationshipsWithForeignKey = append(relationshipsWithForeignKey, foreignKey.RelationshipName)
+		}
+
+		// gqlgen-sqlboiler adds to-one relationships of which it did not recognize the foreign key as a relation
+		fields := boilerModel.Fields[:0]
+		for _, field := range boilerModel.Fields {
+			if field.IsRelation && !field.IsForeignKey && !field.IsArray &&
+				sliceContains(relationshipsWithForeignKey, field.Name) {
+				continue
+			}
+			fields = append(fields, field)
+		}
+		boilerModel.Fields = fields
+	}
+}
+
+// addColumnVariables adds the string slices of the declaration by their name with a lower case model name prefix
+func addColumnVariables(columnVariables map[string][]string, genDecl *ast.GenDecl) {
+	for _, spec := range genDecl.Specs {
+		valueSpec := spec.(*ast.ValueSpec)
+		for i, name := range valueSpec.Names {
+			if i >= len(valueSpec.Values) {
+				continue
+			}
+			compositeLit, ok := valueSpec.Values[i].(*ast.CompositeLit)
+			if !ok {
+				continue
+			}
+			for _, suffix := range []string{"PrimaryKeyColumns", "ColumnsWithDefault"} {
+				if !strings.HasSuffix(name.Name, suffix) {
+					continue
+				}
+				key := strings.ToLower(strings.TrimSuffix(name.Name, suffix)) + suffix
+				columns := []string{}
+				for _, element := range compositeLit.Elts {
+					if basicLit, ok := element.(*ast.BasicLit); ok && basicLit.Kind == token.STRING {
+						column, err := strconv.Unquote(basicLit.Value)
+						if err == nil {
+							columns = append(columns, column)
+						}
+					}
+				}
+				columnVariables[key] = columns
+			}
+		}
+	}
+}
+
+func getGoFields(
+	structType *ast.StructType,
+	file *ast.File,
+	info *types.Info,
+	pkg *types.Package,
+) (map[string]*goField, []string) {
+	fields := map[string]*goField{}
+	var fieldNames []string
+	for _, astField := range structType.Fields.List {
+		field := &goField{
+			TypeName: getGoTypeName(astField.Type, file, info, pkg),
+			Doc:      strings.TrimSpace(astField.Doc.Text()),
+		}
+		if typeAndValue, ok := info.Types[astField.Type]; ok && isValidType(typeAndValue.Type) {
+			field.Type = typeAndValue.Type
+		}
+		if astField.Tag != nil {
+			field.Tag = reflect.StructTag(strings.Trim(astField.Tag.Value, "`"))
+		}
+		for _, name := range astField.Names {
+			fields[name.Name] = field
+			fieldNames = append(fieldNames, name.Name)
+		}
+	}
+	return fields, fieldNames
+}
+
+func getConstants(genDecl *ast.GenDecl, info *types.Info, pkg *types.Package) []*Constant {
+	var constants []*Constant
+	for _, spec := range genDecl.Specs {
+		valueSpec := spec.(*ast.ValueSpec)
+		doc := valueSpec.Doc.Text()
+		if doc == "" {
+			doc = genDecl.Doc.Text()
+		}
+		for _, name := range valueSpec.Names {
+			object, ok := info.Defs[name].(*types.Const)
+			if !ok || !isValidType(object.Type()) {
+				continue
+			}
+			value := object.Val().ExactString()
+			if object.Val().Kind() == constant.String {
+				value = constant.StringVal(object.Val())
+			}
+			constants = append(constants, &Constant{
+				Name:  name.Name,
+				Type:  types.TypeString(types.Default(object.Type()), types.RelativeTo(pkg)),
+				Value: value,
+				Doc:   strings.TrimSpace(doc),
+			})
+		}
+	}
+	return constants
+}
+
+func isFirstCharacterLowerCase(s string) bool {
+	return s != "" && unicode.IsLower([]rune(s)[0])
+}
+
+// getGoTypeName returns the type name of the expression qualified by the import path of its package. If the type
+// could not be resolved the import path is looked up in the imports of the file.
+func getGoTypeName(expr ast.Expr, file *ast.File, info *types.Info, pkg *types.Package) string {
+	if typeAndValue, ok := info.Types[expr]; ok && isValidType(typeAndValue.Type) {
+		return types.TypeString(typeAndValue.Type, types.RelativeTo(pkg))
+	}
+
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.StarExpr:
+		return "*" + getGoTypeName(e.X, file, info, pkg)
+	case *ast.ArrayType:
+		return "[]" + getGoTypeName(e.Elt, file, info, pkg)
+	case *ast.SelectorExpr:
+		if x, ok := e.X.(*ast.Ident); ok {
+			return getImportPath(file, x.Name) + "." + e.Sel.Name
+		}
+	}
+	return types.ExprString(expr)
+}
+
+// getImportPath returns the import path of the package name in the file e.g. null -> github.com/volatiletech/null/v8
+func getImportPath(file *ast.File, packageName string) string {
+	for _, spec := range file.Imports {
+		path := strings.Trim(spec.Path.Value, `"`)
+		if spec.Name != nil && spec.Name.Name == packageName {
+			return path
+		}
+		if spec.Name == nil && getPackageName(path) == packageName {
+			return path
+		}
+	}
+	return packageName
+}
+
+var majorVersionRegex = regexp.MustCompile(`^v[0-9]+$`) //nolint:gochecknoglobals
+
+// getPackageName returns the package name of an import path e.g. github.com/volatiletech/null/v8 -> null and
+// gopkg.in/volatiletech/null.v6 -> null
+func getPackageName(importPath string) string {
+	parts := strings.Split(importPath, "/")
+	name := parts[len(parts)-1]
+	if majorVersionRegex.MatchString(name) && len(parts) > 1 {
+		name = parts[len(parts)-2]
+	}
+	if i := strings.Index(name, ".v"); i != -1 {
+		name = name[:i]
+	}
+	return name
+}
+
+// isValidType returns false if the type or a type it is composed of (e.g. []null.String) could not be resolved
+func isValidType(t types.Type) bool {
+	switch t := t.(type) {
+	case nil:
+		return false
+	case *types.Basic:
+		return t.Kind() != types.Invalid
+	case *types.Pointer:
+		return isValidType(t.Elem())
+	case *types.Slice:
+		return isValidType(t.Elem())
+	case *types.Array:
+		return isValidType(t.Elem())
+	case *types.Map:
+		return isValidType(t.Key()) && isValidType(t.Elem())
+	}
+	return true
+}
+
+// toGraphQLType returns the GraphQL type of a Go type e.g. github.com/volatiletech/null/v8.String -> String. The type
+// name may also be qualified by the package name only e.g. null.String since the database models have no imports.
+func toGraphQLType(typeName string, t types.Type) string {
+	typeName = strings.TrimPrefix(typeName, "*")
+
+	// the type of the elements of lists e.g. []string
+	if isListType(typeName) && strings.HasPrefix(typeName, "[]") {
+		if slice, ok := t.(*types.Slice); ok {
+			return toGraphQLType(typeName[2:], slice.Elem())
+		}
+		return toGraphQLType(typeName[2:], nil)
+	}
+
+	var packageName string
+	if i := strings.LastIndex(typeName, "."); i != -1 {
+		packageName = getPackageName(typeName[:i])
+		typeName = typeName[i+1:]
+	}
+
+	switch packageName {
+	case "":
+		if graphQLType := basicToGraphQLType(typeName); graphQLType != "" {
+			return graphQLType
+		}
+	case "null":
+		if graphQLType := basicToGraphQLType(strings.ToLower(typeName)); graphQLType != "" {
+			return graphQLType
+		}
+		if typeName == "Time" {
+			return "Int"
+		}
+	case "time":
+		// TODO: make this a scalar or something configurable?
+		// I like to use unix here
+		if typeName == "Time" {
+			return "Int"
+		}
+	case "types":
+		switch typeName {
+		case "Decimal", "NullDecimal", "DecimalArray", "Float64Array":
+			return "Float"
+		case "StringArray":
+			return "String"
+		case "Int64Array":
+			return "Int"
+		case "BoolArray":
+			return "Boolean"
+		}
+	}
+
+	// e.g. type Status string
+	if t != nil {
+		if basic, ok := t.Underlying().(*types.Basic); ok {
+			if graphQLType := basicToGraphQLType(basic.Name()); graphQLType != "" {
+				return graphQLType
+			}
+		}
+	}
+
+	// E.g. UserSlice
+	return strings.TrimSuffix(typeName, "Slice")
+}
+
+// isListType returns true for array columns e.g. types.StringArray for text[] in postgres or []string
+func isListType(typeName string) bool {
+	typeName = strings.TrimPrefix(typeName, "*")
+	if strings.HasPrefix(typeName, "[]") {
+		return typeName != "[]byte" && typeName != "[]uint8"
+	}
+	i := strings.LastIndex(typeName, ".")
+	return i != -1 && getPackageName(typeName[:i]) == "types" && strings.HasSuffix(typeName[i+1:], "Array")
+}
+
+// isJSONType returns true for json columns e.g. types.JSON or null.JSON
+func isJSONType(typeName string) bool {
+	typeName = strings.TrimPrefix(typeName, "*")
+	i := strings.LastIndex(typeName, ".")
+	if i == -1 || typeName[i+1:] != "JSON" {
+		return false
+	}
+	packageName := getPackageName(typeName[:i])
+	return packageName == "types" || packageName == "null"
+}
+
+// isBinaryType returns true for binary columns e.g. []byte, null.Bytes or types.BytesArray
+func isBinaryType(typeName string) bool {
+	typeName = strings.TrimPrefix(typeName, "*")
+	if typeName == "[]byte" || typeName == "[]uint8" {
+		return true
+	}
+	i := strings.LastIndex(typeName, ".")
+	if i == -1 {
+		return false
+	}
+	packageName := getPackageName(typeName[:i])
+	return packageName == "null" && typeName[i+1:] == "Bytes" || packageName == "types" && typeName[i+1:] == "BytesArray"
+}
+
+// basicToGraphQLType returns the GraphQL type of a basic Go type e.g. int64 -> Int or an empty string if there is none
+func basicToGraphQLType(typeName string) string {
+	switch typeName {
+	case "string":
+		return "String"
+	case "bool":
+		return "Boolean"
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "byte", "rune":
+		return "Int"
+	case "float32", "float64":
+		return "Float"
+	}
+	return ""
+}
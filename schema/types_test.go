@@ -0,0 +1,270 @@
+package schema
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	gqlgen_sqlboiler "github.com/web-ridge/gqlgen-sqlboiler/v2"
+)
+
+const typesModel = `package models
+
+import (
+	"time"
+
+	"github.com/volatiletech/null/v8"
+)
+
+type Point struct {
+	X float64
+	Y float64
+}
+
+type Interval time.Duration
+
+type Status string
+
+type Payment struct {
+	ID        string      ` + "`boil:\"id\"`" + `
+	Code      string      ` + "`boil:\"code\"`" + `
+	InvoiceID null.Int    ` + "`boil:\"invoice_id\"`" + `
+	Paid      bool        ` + "`boil:\"paid\"`" + `
+	Valid     null.Bool   ` + "`boil:\"valid\"`" + `
+	Location  Point       ` + "`boil:\"location\"`" + `
+	Retry     Interval    ` + "`boil:\"retry\"`" + `
+	Status    Status      ` + "`boil:\"status\"`" + `
+	Note      null.String ` + "`boil:\"note\"`" + `
+	// PaidAt is when the payment has been received
+	PaidAt    time.Time   ` + "`boil:\"paid_at\"`" + `
+}
+
+var (
+	paymentPrimaryKeyColumns  = []string{"code"}
+	paymentColumnsWithDefault = []string{"valid"}
+)
+
+// Enum values for payment_status
+const (
+	PaymentStatusOpen Status = "open"
+	PaymentRetries = 3
+)
+`
+
+func TestLoadGoPackage(t *testing.T) {
+	// the models are in this module so the imports of the models are resolved
+	dir, err := ioutil.TempDir("testdata", "models")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "payments.go"), []byte(typesModel), 0644); err != nil { //nolint:gosec
+		t.Fatal(err)
+	}
+
+	goPackage, err := loadGoPackage(dir)
+	if err != nil {
+		t.Fatalf("could not load models: %v", err)
+	}
+
+	payment := goPackage.Models["Payment"]
+	if payment == nil {
+		t.Fatal("model Payment has not been loaded")
+	}
+	if !sliceEqual(payment.PrimaryKeyColumns, []string{"code"}) ||
+		!sliceEqual(payment.ColumnsWithDefault, []string{"valid"}) {
+		t.Errorf("unexpected primary key %v or columns with default %v", payment.PrimaryKeyColumns,
+			payment.ColumnsWithDefault)
+	}
+
+	expectedTypes := map[string]string{
+		"ID":        "String",
+		"Code":      "ID",
+		"InvoiceID": "ID",
+		"Paid":      "Boolean",
+		"Valid":     "Boolean",
+		"Location":  "Point",
+		"Retry":     "Int",
+		"Status":    "String",
+		"Note":      "String",
+		"PaidAt":    "Int",
+	}
+	for name, expectedType := range expectedTypes {
+		if payment.getField(name) == nil {
+			t.Errorf("field %v has not been loaded", name)
+			continue
+		}
+		boilerField := &gqlgen_sqlboiler.BoilerField{Name: name}
+		if name == "InvoiceID" {
+			boilerField.IsRelation = true
+			boilerField.IsForeignKey = true
+			boilerField.Relationship = &gqlgen_sqlboiler.BoilerModel{Name: "Invoice"}
+		}
+		field := newModelConverter(Config{}).boilerFieldToField(boilerField, payment)
+		if field.Type != expectedType {
+			t.Errorf("expected %v (%v) to be %v but got %v", name, field.GoType, expectedType, field.Type)
+		}
+		// Point has no GraphQL type so it becomes a scalar
+		if field.IsCustomType != (name == "Location") {
+			t.Errorf("expected %v to be a custom type: %v", name, name == "Location")
+		}
+	}
+
+	paidAt := payment.getField("PaidAt")
+	if paidAt.TypeName != "time.Time" || paidAt.Doc != "PaidAt is when the payment has been received" {
+		t.Errorf("unexpected type or doc comment of PaidAt: %v %q", paidAt.TypeName, paidAt.Doc)
+	}
+	if note := payment.getField("Note"); note.TypeName != "github.com/volatiletech/null/v8.String" ||
+		note.columnName() != "note" {
+		t.Errorf("unexpected type or column of Note: %v %v", note.TypeName, note.columnName())
+	}
+
+	if len(goPackage.Constants) != 2 {
+		t.Fatalf("expected 2 constants but got %v", len(goPackage.Constants))
+	}
+	status := goPackage.Constants[0]
+	if status.Name != "PaymentStatusOpen" || status.Type != "Status" || status.Value != "open" ||
+		status.Doc != "Enum values for payment_status" {
+		t.Errorf("unexpected constant %+v", status)
+	}
+	if retries := goPackage.Constants[1]; retries.Type != "int" || retries.Value != "3" {
+		t.Errorf("unexpected constant %+v", retries)
+	}
+}
+
+func TestCustomTypeScalar(t *testing.T) {
+	dir, err := ioutil.TempDir("testdata", "models")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files, err := ioutil.ReadDir(filepath.Join("testdata", "tree"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		content, err := ioutil.ReadFile(filepath.Join("testdata", "tree", file.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		// a column of which the Go type is a struct e.g. a point column with a custom sqlboiler type
+		model := strings.NewReplacer("import (", "import (\n\t\"image\"",
+			"\tPosition int ", "\tLocation image.Point `boil:\"location\"`\n\tPosition int ").Replace(string(content))
+		if err := ioutil.WriteFile(filepath.Join(dir, file.Name()), []byte(model), 0644); err != nil { //nolint:gosec
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		customTypeScalar string
+		expected         []string
+	}{
+		{expected: []string{"scalar Point", "location: Point!"}},
+		{customTypeScalar: "Geometry", expected: []string{"scalar Geometry", "location: Geometry!"}},
+		{customTypeScalar: "String", expected: []string{"location: String!"}},
+	}
+	for _, test := range tests {
+		document := generateSchema(t, Config{ModelDirectory: dir, Mutations: true,
+			CustomTypeScalar: test.customTypeScalar})
+		assertSchemaContains(t, document.SDL, test.expected...)
+		if strings.Contains(document.SDL, "location: "+test.customTypeScalar+"Filter") ||
+			strings.Contains(document.SDL, "location: PointFilter") {
+			t.Errorf("expected location not to be filterable")
+		}
+		assertValidSchema(t, document.SDL)
+	}
+}
+
+const relationshipsModel = `package models
+
+type Post struct {
+	ID         string ` + "`boil:\"id\"`" + `
+	OwnerUUID  string ` + "`boil:\"owner_uuid\"`" + `
+	CreatedBy  string ` + "`boil:\"created_by\"`" + `
+	ExternalID string ` + "`boil:\"external_id\"`" + `
+
+	R *postR ` + "`boil:\"-\"`" + `
+	L postL  ` + "`boil:\"-\"`" + `
+}
+
+type postR struct {
+	Owner   *User ` + "`boil:\"Owner\"`" + `
+	Creator *User ` + "`boil:\"Creator\"`" + `
+}
+
+type postL struct{}
+
+type User struct {
+	ID   string ` + "`boil:\"id\"`" + `
+	Name string ` + "`boil:\"name\"`" + `
+
+	R *userR ` + "`boil:\"-\"`" + `
+	L userL  ` + "`boil:\"-\"`" + `
+}
+
+type userR struct {
+	CreatorPosts PostSlice ` + "`boil:\"CreatorPosts\"`" + `
+}
+
+type userL struct{}
+
+type PostSlice []*Post
+
+type query struct{}
+
+func (query) Where(clause string, args ...interface{}) query {
+	return query{}
+}
+
+// Creator is the relationship of which the foreign key is not named after it
+func (o *Post) Creator() query {
+	return query{}.Where("\"id\" = ?", o.CreatedBy)
+}
+
+var (
+	postPrimaryKeyColumns = []string{"id"}
+	userPrimaryKeyColumns = []string{"id"}
+)
+`
+
+func TestForeignKeys(t *testing.T) {
+	dir, err := ioutil.TempDir("testdata", "models")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	tableNames := "package models\n\nvar TableNames = struct {\n\tPosts string\n\tUsers string\n}{\n\tPosts: \"posts\",\n\tUsers: \"users\",\n}\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "boil_table_names.go"), []byte(tableNames), 0644); err != nil { //nolint:gosec
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "models.go"), []byte(relationshipsModel), 0644); err != nil { //nolint:gosec
+		t.Fatal(err)
+	}
+
+	document := generateSchema(t, Config{ModelDirectory: dir, Mutations: true})
+	post := getDefinition(t, document.SDL, "type Post {")
+	// the foreign keys come from the relationships instead of the names of the fields
+	assertSchemaContains(t, post, "owner: User!", "creator: User!", "externalId: String!")
+	for _, unexpected := range []string{"ownerUuid", "createdBy", "external: "} {
+		if strings.Contains(post, unexpected) {
+			t.Errorf("expected %v not to be in\n%v", unexpected, post)
+		}
+	}
+	assertSchemaContains(t, getDefinition(t, document.SDL, "input PostCreateInput {"),
+		"ownerUuid: ID!", "createdBy: ID!", "externalId: String!")
+	assertValidSchema(t, document.SDL)
+}
+
+func sliceEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,223 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// WatchOptions decides how Watch regenerates the schema
+type WatchOptions struct {
+	MergeOptions
+
+	// Debounce waits until the models have not been changed for this duration since sqlboiler writes all
+	// models in a burst, defaults to 500ms
+	Debounce time.Duration
+	// PollInterval is how often the models directory is checked for changes, defaults to 200ms or 2s when the
+	// database is introspected
+	PollInterval time.Duration
+	// Command runs after every regeneration e.g. []string{"go", "run", "github.com/99designs/gqlgen"}
+	Command []string
+	// Stop stops watching when closed, nil watches forever
+	Stop <-chan struct{}
+}
+
+// Watch regenerates the schema in filename every time the models in config.ModelDirectory change, or the tables of
+// config.Database if the schema is generated from a database
+func Watch(config Config, filename string, options WatchOptions) error {
+	if options.Debounce == 0 {
+		options.Debounce = 500 * time.Millisecond
+	}
+	if options.PollInterval == 0 && config.Database != nil {
+		options.PollInterval = 2 * time.Second
+	}
+	if options.PollInterval == 0 {
+		options.PollInterval = 200 * time.Millisecond
+	}
+
+	getSnapshot := func() (string, error) {
+		return getModelsSnapshot(config.ModelDirectory)
+	}
+	watched := config.ModelDirectory
+	if config.Database != nil {
+		getSnapshot = func() (string, error) {
+			return getDatabaseSnapshot(*config.Database)
+		}
+		watched = "the " + config.Database.Driver + " database"
+	}
+
+	previous, err := getSnapshot()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Watching", watched, "for changes")
+	regenerate(config, filename, options)
+
+	ticker := time.NewTicker(options.PollInterval)
+	defer ticker.Stop()
+
+	var lastChange time.Time
+	for {
+		select {
+		case <-options.Stop:
+			return nil
+		case now := <-ticker.C:
+			current, err := getSnapshot()
+			if err != nil {
+				fmt.Println("[warn] could not read models: ", err)
+				continue
+			}
+			if previous != current {
+				previous = current
+				lastChange = now
+				continue
+			}
+			if !lastChange.IsZero() && now.Sub(lastChange) >= options.Debounce {
+				lastChange = time.Time{}
+				regenerate(config, filename, options)
+			}
+		}
+	}
+}
+
+// regenerate writes the schema, prints what has been changed and runs the follow-up command. Errors are printed
+// so we keep on watching e.g. after a merge conflict
+func regenerate(config Config, filename string, options WatchOptions) {
+	var previousSchema string
+	if content, err := ioutil.ReadFile(filename); err == nil {
+		previousSchema = string(content)
+	}
+
+	if err := Write(config, filename, options.MergeOptions); err != nil {
+		fmt.Println("[error] could not regenerate schema: ", err)
+		return
+	}
+
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		fmt.Println("[error] could not read regenerated schema: ", err)
+		return
+	}
+	if previousSchema != "" {
+		changes, err := Diff(previousSchema, string(content))
+		if err != nil {
+			fmt.Println("[warn] could not compare schemas: ", err)
+		} else {
+			fmt.Print(summarizeChanges(filename, changes))
+		}
+	}
+
+	if len(options.Command) == 0 {
+		return
+	}
+	fmt.Println("Running", strings.Join(options.Command, " "))
+	cmd := exec.Command(options.Command[0], options.Command[1:]...) //nolint:gosec
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		fmt.Println("[error] command failed: ", err)
+	}
+}
+
+// summarizeChanges returns a line with the amount of changes per level followed by every change
+func summarizeChanges(filename string, changes []*SchemaChange) string {
+	if len(changes) == 0 {
+		return filename + ": no changes" + lineBreak
+	}
+
+	counts := map[ChangeLevel]int{}
+	for _, change := range changes {
+		counts[change.Level]++
+	}
+
+	var s strings.Builder
+	s.WriteString(fmt.Sprintf("%v: %v changes (%v breaking, %v dangerous, %v safe)", filename, len(changes),
+		counts[ChangeLevelBreaking], counts[ChangeLevelDangerous], counts[ChangeLevelSafe]))
+	s.WriteString(lineBreak)
+	for _, change := range changes {
+		s.WriteString("  " + change.String())
+		s.WriteString(lineBreak)
+	}
+	return s.String()
+}
+
+// getModelsSnapshot returns the modification time and size of every model file, the same files as which are parsed
+func getModelsSnapshot(dir string) (string, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return "", fmt.Errorf("could not read models directory %v: %v", dir, err)
+	}
+	var s strings.Builder
+	for _, file := range files {
+		name := strings.ToLower(file.Name())
+		if file.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		s.WriteString(fmt.Sprintf("%v %v %v", filepath.Join(dir, file.Name()), file.Size(), file.ModTime().UnixNano()))
+		s.WriteString(lineBreak)
+	}
+	return s.String(), nil
+}
+
+// getDatabaseSnapshot returns the introspected tables with their columns and constraints
+func getDatabaseSnapshot(config DatabaseConfig) (string, error) {
+	tables, err := introspectDatabase(config)
+	if err != nil {
+		return "", err
+	}
+	snapshot, err := json.Marshal(tables)
+	if err != nil {
+		return "", fmt.Errorf("could not marshal tables: %v", err)
+	}
+	return string(snapshot), nil
+}
+
+// ParseCommand splits a command line into its arguments the way a shell does e.g.
+// go run "github.com/99designs/gqlgen" --config 'my config.yml' results in 5 arguments
+func ParseCommand(command string) ([]string, error) {
+	var arguments []string
+	var argument strings.Builder
+	var quote rune
+	var inArgument, escaped bool
+	for _, r := range command {
+		switch {
+		case escaped:
+			argument.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped, inArgument = true, true
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			argument.WriteRune(r)
+		case r == '"' || r == '\'':
+			quote, inArgument = r, true
+		case unicode.IsSpace(r):
+			if inArgument {
+				arguments = append(arguments, argument.String())
+				argument.Reset()
+				inArgument = false
+			}
+		default:
+			argument.WriteRune(r)
+			inArgument = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote in command %v", quote, command)
+	}
+	if escaped {
+		return nil, fmt.Errorf("command %v ends with an escape character", command)
+	}
+	if inArgument {
+		arguments = append(arguments, argument.String())
+	}
+	return arguments, nil
+}
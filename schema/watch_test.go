@@ -0,0 +1,197 @@
+package schema
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakePrettier puts a prettier on the PATH which leaves the files and the content of stdin as they are
+func fakePrettier(t *testing.T, dir string) func() {
+	t.Helper()
+	bin, err := filepath.Abs(filepath.Join(dir, "bin"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(bin, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(bin, "prettier"), []byte("#!/bin/sh\nif [ \"$1\" = \"--stdin-filepath\" ]; then cat; fi\nexit 0\n"), 0755); err != nil { //nolint:gosec
+		t.Fatal(err)
+	}
+	path := os.Getenv("PATH")
+	if err := os.Setenv("PATH", bin+string(os.PathListSeparator)+path); err != nil {
+		t.Fatal(err)
+	}
+	return func() {
+		_ = os.Setenv("PATH", path)
+	}
+}
+
+func countLines(t *testing.T, filename string) int {
+	t.Helper()
+	content, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return 0
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.Count(string(content), "\n")
+}
+
+func waitForLines(t *testing.T, filename string, lines int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for countLines(t, filename) < lines {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %v regenerations but got %v", lines, countLines(t, filename))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestWatch(t *testing.T) {
+	// the models are loaded with the module they are in
+	dir, err := ioutil.TempDir("testdata", "watch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer fakePrettier(t, dir)()
+
+	modelDirectory := filepath.Join(dir, "models")
+	if err := os.MkdirAll(modelDirectory, 0755); err != nil {
+		t.Fatal(err)
+	}
+	files, err := ioutil.ReadDir(filepath.Join("testdata", "tree"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		content, err := ioutil.ReadFile(filepath.Join("testdata", "tree", file.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(modelDirectory, file.Name()), content, 0644); err != nil { //nolint:gosec
+			t.Fatal(err)
+		}
+	}
+
+	filename := filepath.Join(dir, "schema.graphql")
+	runs := filepath.Join(dir, "runs")
+	stop := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- Watch(Config{ModelDirectory: modelDirectory}, filename, WatchOptions{
+			Debounce:     200 * time.Millisecond,
+			PollInterval: 10 * time.Millisecond,
+			Command:      []string{"sh", "-c", "echo run >> '" + runs + "'"},
+			Stop:         stop,
+		})
+	}()
+
+	// the schema is generated when watching starts
+	waitForLines(t, filename, 1)
+	waitForLines(t, runs, 1)
+
+	// a burst of changes results in one regeneration after the debounce
+	modelFile := filepath.Join(modelDirectory, files[0].Name())
+	for i := 0; i < 3; i++ {
+		file, err := os.OpenFile(modelFile, os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := file.WriteString("// changed\n"); err != nil {
+			t.Fatal(err)
+		}
+		if err := file.Close(); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(50 * time.Millisecond)
+		if lines := countLines(t, runs); lines != 1 {
+			t.Errorf("expected no regeneration while the models are changing but got %v", lines-1)
+		}
+	}
+	waitForLines(t, runs, 2)
+	time.Sleep(400 * time.Millisecond)
+	if lines := countLines(t, runs); lines != 2 {
+		t.Errorf("expected one regeneration after a burst of changes but got %v", lines-1)
+	}
+
+	close(stop)
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("expected watch to stop without error but got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected watch to stop")
+	}
+}
+
+func TestDatabaseSnapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "watch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	config := DatabaseConfig{Driver: "sqlite3", DSN: "file:" + filepath.Join(dir, "test.db")}
+	db, err := sql.Open(config.Driver, config.DSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := db.Exec("CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT NOT NULL)"); err != nil {
+		t.Fatal(err)
+	}
+
+	previous, err := getDatabaseSnapshot(config)
+	if err != nil {
+		t.Fatalf("could not snapshot database: %v", err)
+	}
+	if current, err := getDatabaseSnapshot(config); err != nil || current != previous {
+		t.Errorf("expected the snapshot of an unchanged database to be the same, error: %v", err)
+	}
+	if _, err := db.Exec("ALTER TABLE users ADD COLUMN email TEXT"); err != nil {
+		t.Fatal(err)
+	}
+	if current, err := getDatabaseSnapshot(config); err != nil || current == previous {
+		t.Errorf("expected the snapshot to change when a column is added, error: %v", err)
+	}
+}
+
+func TestParseCommand(t *testing.T) {
+	tests := []struct {
+		command  string
+		expected []string
+	}{
+		{"", nil},
+		{"go run github.com/99designs/gqlgen", []string{"go", "run", "github.com/99designs/gqlgen"}},
+		{"  make   generate ", []string{"make", "generate"}},
+		{`gqlgen --config "my config.yml"`, []string{"gqlgen", "--config", "my config.yml"}},
+		{`sh -c 'echo "done" && ls'`, []string{"sh", "-c", `echo "done" && ls`}},
+		{`echo "" a\ b`, []string{"echo", "", "a b"}},
+	}
+	for _, test := range tests {
+		arguments, err := ParseCommand(test.command)
+		if err != nil {
+			t.Errorf("could not parse %q: %v", test.command, err)
+			continue
+		}
+		if strings.Join(arguments, "|") != strings.Join(test.expected, "|") || len(arguments) != len(test.expected) {
+			t.Errorf("expected %q to be parsed as %q but got %q", test.command, test.expected, arguments)
+		}
+	}
+
+	for _, command := range []string{`echo "unterminated`, `echo 'unterminated`, `echo \`} {
+		if _, err := ParseCommand(command); err == nil {
+			t.Errorf("expected %q to be invalid", command)
+		}
+	}
+}
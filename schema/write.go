@@ -0,0 +1,174 @@
+package schema
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+)
+
+// MergeOptions decides what happens with an existing schema when writing
+type MergeOptions struct {
+	// MergeSchema uses a three way merge to keep your customization of an existing schema
+	MergeSchema bool
+	// IntrospectionFile is the name of the introspection result JSON of the written (merged) schema e.g. schema.json,
+	// it is written next to the schema, empty skips it
+	IntrospectionFile string
+}
+
+// Write generates the schema and writes it to filename, formatted with prettier
+func Write(config Config, filename string, options MergeOptions) error {
+	if config.Deprecation != nil && config.Deprecation.PreviousSchema == "" && fileExists(filename) {
+		previousSchema, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return fmt.Errorf("could not read previous schema %v: %v", filename, err)
+		}
+		deprecation := *config.Deprecation
+		deprecation.PreviousSchema = string(previousSchema)
+		config.Deprecation = &deprecation
+	}
+
+	document, err := Generate(config)
+	if err != nil {
+		return err
+	}
+	schema := document.SDL
+
+	if !options.MergeSchema || !fileExists(filename) {
+		fmt.Printf("Write schema of %v bytes to %v \n", len(schema), filename)
+		if err := writeContentToFile(filename, schema); err != nil {
+			return fmt.Errorf("could not write schema to disk: %v", err)
+		}
+		if err := formatFile(filename); err != nil {
+			return err
+		}
+		return writeIntrospection(config, filename, options)
+	}
+
+	baseFile := filenameWithoutExtension(filename) +
+		"-empty" +
+		getFilenameExtension(filename)
+
+	newOutputFile := filenameWithoutExtension(filename) +
+		"-new" +
+		getFilenameExtension(filename)
+
+	// remove previous files if exist
+	_ = os.Remove(baseFile)
+	_ = os.Remove(newOutputFile)
+
+	if err := writeContentToFile(newOutputFile, schema); err != nil {
+		return fmt.Errorf("could not write schema to disk: %v", err)
+	}
+	if err := formatFile(filename); err != nil {
+		return fmt.Errorf("could not format with prettier %v: %v", filename, err)
+	}
+	if err := formatFile(newOutputFile); err != nil {
+		return fmt.Errorf("could not format with prettier %v: %v", newOutputFile, err)
+	}
+
+	// Three way merging done based on this answer
+	// https://stackoverflow.com/a/9123563/2508481
+
+	// Empty file as base per the stackoverflow answer
+	name := "touch"
+	args := []string{baseFile}
+	out, err := exec.Command(name, args...).Output()
+	if err != nil {
+		fmt.Println("Executing command failed: ", name, strings.Join(args, " "))
+		return fmt.Errorf("merging failed %v: %v", err, out)
+	}
+
+	// Let's do the merge
+	name = "git"
+	args = []string{"merge-file", filename, baseFile, newOutputFile}
+	out, err = exec.Command(name, args...).Output()
+	if err != nil {
+		fmt.Println("Executing command failed: ", name, strings.Join(args, " "))
+		// remove base file
+		_ = os.Remove(baseFile)
+		return fmt.Errorf("merging failed or had conflicts %v: %v", err, out)
+	}
+
+	fmt.Println("Merging done without conflicts: ", out)
+
+	// remove files
+	_ = os.Remove(baseFile)
+	_ = os.Remove(newOutputFile)
+
+	return writeIntrospection(config, filename, options)
+}
+
+// writeIntrospection writes the introspection result of the schema in filename next to it
+func writeIntrospection(config Config, filename string, options MergeOptions) error {
+	if options.IntrospectionFile == "" {
+		return nil
+	}
+	schema, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("could not read schema %v: %v", filename, err)
+	}
+	introspection, err := Introspect(string(schema), config)
+	if err != nil {
+		return fmt.Errorf("could not introspect %v: %v", filename, err)
+	}
+	introspectionFile := path.Join(path.Dir(filename), options.IntrospectionFile)
+	fmt.Printf("Write introspection of %v bytes to %v \n", len(introspection), introspectionFile)
+	if err := writeContentToFile(introspectionFile, introspection); err != nil {
+		return fmt.Errorf("could not write introspection to disk: %v", err)
+	}
+	return nil
+}
+
+func getFilenameExtension(fn string) string {
+	return path.Ext(fn)
+}
+
+func filenameWithoutExtension(fn string) string {
+	return strings.TrimSuffix(fn, path.Ext(fn))
+}
+
+func formatFile(filename string) error {
+	name := "prettier"
+	args := []string{filename, "--write"}
+
+	out, err := exec.Command(name, args...).Output()
+	if err != nil {
+		return fmt.Errorf("executing command: '%v %v' failed with: %v, output: %v", name, strings.Join(args, " "), err, out)
+	}
+	// fmt.Println(fmt.Sprintf("Formatting of %v done", filename))
+	return nil
+}
+
+func writeContentToFile(filename string, content string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("could not write %v to disk: %v", filename, err)
+	}
+
+	// Close file if this functions returns early or at the end
+	defer func() {
+		closeErr := file.Close()
+		if closeErr != nil {
+			fmt.Println("Error while closing file: ", closeErr)
+		}
+	}()
+
+	if _, err := file.WriteString(content); err != nil {
+		return fmt.Errorf("could not write content to file %v: %v", filename, err)
+	}
+
+	return nil
+}
+
+// fileExists checks if a file exists and is not a directory before we
+// try using it to prevent further errors.
+func fileExists(filename string) bool {
+	info, err := os.Stat(filename)
+	if os.IsNotExist(err) {
+		return false
+	}
+	return !info.IsDir()
+}